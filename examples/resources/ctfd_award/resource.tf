@@ -0,0 +1,17 @@
+resource "ctfd_award" "writeup" {
+  user_id     = ctfd_user.ctfer.id
+  name        = "Best writeup"
+  description = "For the most detailed writeup of the CTF."
+  value       = 100
+  category    = "Writeups"
+  icon        = "crown"
+}
+
+resource "ctfd_award" "cheating" {
+  team_id     = ctfd_team.team1.id
+  name        = "Flag sharing"
+  description = "Shared a flag with another team."
+  value       = -200
+  category    = "Penalties"
+  icon        = "ban"
+}
@@ -0,0 +1,10 @@
+resource "ctfd_config" "event" {
+  values = {
+    ctf_name         = "My CTF"
+    ctf_description  = "A Capture The Flag event, as code."
+    user_mode        = "teams"
+    team_size        = "4"
+    score_visibility = "public"
+    paused           = "false"
+  }
+}
@@ -0,0 +1,23 @@
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "ctfd_email_settings" "smtp" {
+  from_address = "noreply@ctfer.io"
+
+  smtp_host                = "smtp.ctfer.io"
+  smtp_port                = 587
+  smtp_tls                 = true
+  smtp_username            = "ctfd"
+  smtp_password_wo         = var.smtp_password
+  smtp_password_wo_version = 1
+
+  verify_emails = true
+
+  verification_email = {
+    subject = "Confirm your account for {ctf_name}"
+    body    = "Welcome to {ctf_name}!\n\nClick the following link to confirm your email address: {url}"
+  }
+}
@@ -0,0 +1,6 @@
+resource "ctfd_event_schedule" "edition" {
+  start    = "2026-11-14T09:00:00"
+  freeze   = "2026-11-15T16:00:00"
+  end      = "2026-11-15T18:00:00"
+  timezone = "Europe/Paris"
+}
@@ -0,0 +1,25 @@
+resource "ctfd_field" "school" {
+  type        = "user"
+  name        = "School"
+  description = "The school you are studying in."
+  required    = true
+  public      = true
+}
+
+resource "ctfd_field" "newsletter" {
+  type       = "user"
+  field_type = "boolean"
+  name       = "Newsletter"
+  editable   = true
+}
+
+resource "ctfd_user" "ctfer" {
+  name     = "CTFer"
+  email    = "ctfer-io@protonmail.com"
+  password = "password"
+
+  fields = {
+    (ctfd_field.school.id)     = "ENSIMAG"
+    (ctfd_field.newsletter.id) = "true"
+  }
+}
@@ -0,0 +1,12 @@
+resource "ctfd_notification" "wave" {
+  title   = "New challenges"
+  content = "A new wave of challenges is out, good luck!"
+  type    = "alert"
+}
+
+resource "ctfd_notification" "hint" {
+  title   = "Stuck?"
+  content = "Have a look at the Discord server."
+  sound   = false
+  team_id = ctfd_team.team1.id
+}
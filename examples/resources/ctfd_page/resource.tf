@@ -0,0 +1,13 @@
+resource "ctfd_page" "rules" {
+  title   = "Rules"
+  route   = "rules"
+  content = file("${path.module}/rules.md")
+}
+
+resource "ctfd_page" "sponsors" {
+  title         = "Sponsors"
+  route         = "sponsors"
+  content       = "<h1>Thanks to our sponsors!</h1>"
+  format        = "html"
+  auth_required = true
+}
@@ -0,0 +1,33 @@
+# The instance has no administrator yet, so only its URL is known
+provider "ctfd" {
+  alias     = "setup"
+  url       = "https://my-ctfd.lan"
+  anonymous = true
+}
+
+resource "ctfd_setup" "instance" {
+  provider = ctfd.setup
+
+  ctf_name  = "24h IUT"
+  user_mode = "teams"
+  team_size = 4
+  start     = "2026-11-14T09:00:00+01:00"
+  end       = "2026-11-15T18:00:00+01:00"
+
+  name     = "ctfer"
+  email    = "ctfer-io@protonmail.com"
+  password = var.admin_password
+
+  api_key_expiration = "2026-12-31"
+}
+
+# Manages the other resources once set up
+provider "ctfd" {
+  url     = "https://my-ctfd.lan"
+  api_key = ctfd_setup.instance.api_key
+}
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,20 @@
+resource "ctfd_theme" "branding" {
+  header = "<style>.navbar { background-color: #1a1a2e !important; }</style>"
+  footer = "<footer class=\"text-center\">Powered by CTFer.io</footer>"
+  settings = jsonencode({
+    challenge_window_size = "xl"
+  })
+
+  logo = {
+    name       = "logo.png"
+    contentb64 = filebase64("${path.module}/logo.png")
+  }
+  banner = {
+    name       = "banner.png"
+    contentb64 = filebase64("${path.module}/banner.png")
+  }
+  favicon = {
+    name       = "favicon.png"
+    contentb64 = filebase64("${path.module}/favicon.png")
+  }
+}
@@ -0,0 +1,16 @@
+resource "ctfd_token" "ci" {
+  description  = "CI pipeline"
+  expiration   = "2026-12-31"
+  rotate_after = "720h"
+}
+
+resource "ctfd_token" "bot" {
+  username    = "bot"
+  password    = var.bot_password
+  description = "Discord bot"
+}
+
+output "ci_token" {
+  value     = ctfd_token.ci.value
+  sensitive = true
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrAnonymous is returned by API calls when the provider has no credentials.
+var ErrAnonymous = errors.New("the CTFd provider has no credentials, only ctfd_setup could be used")
+
+// anonymousTransport rejects all requests, as CTFd would deny them to an
+// anonymous client with a less explicit error.
+type anonymousTransport struct{}
+
+var _ http.RoundTripper = (*anonymousTransport)(nil)
+
+func (rt *anonymousTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	return nil, fmt.Errorf("%w, refusing %s %s", ErrAnonymous, req.Method, req.URL.Path)
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = (*awardDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*awardDataSource)(nil)
+)
+
+func NewAwardDataSource() datasource.DataSource {
+	return &awardDataSource{}
+}
+
+type awardDataSource struct {
+	fm *Framework
+}
+
+type awardsDataSourceModel struct {
+	ID     types.String         `tfsdk:"id"`
+	Awards []awardResourceModel `tfsdk:"awards"`
+}
+
+func (data *awardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_awards"
+}
+
+func (data *awardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The awards given to users and teams.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"awards": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the award, used internally to handle the CTFd corresponding object.",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "User who received the award, if any.",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "Team which received the award, if any.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the award, displayed to the players.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the award, e.g. why it has been given.",
+							Computed:            true,
+						},
+						"value": schema.Int64Attribute{
+							MarkdownDescription: "Points of the award, negative for a penalty.",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Category of the award (e.g. \"Writeups\", \"Penalties\").",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "Icon of the award in the theme.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (data *awardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	data.fm = fm
+}
+
+func (data *awardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, data.fm.Tp.Tracer(serviceName), data)
+	defer span.End()
+
+	var state awardsDataSourceModel
+
+	awards, _, err := data.fm.Client.GetAwards(ctx, &api.GetAwardsParams{}, WithTracerProvider(data.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read CTFd Awards",
+			err.Error(),
+		)
+		return
+	}
+
+	// Flatten response
+	state.Awards = make([]awardResourceModel, 0, len(awards))
+	for _, award := range awards {
+		state.Awards = append(state.Awards, flattenAward(award))
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*awardResource)(nil)
+	_ resource.ResourceWithConfigure   = (*awardResource)(nil)
+	_ resource.ResourceWithImportState = (*awardResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*awardResource)(nil)
+)
+
+func NewAwardResource() resource.Resource {
+	return &awardResource{}
+}
+
+type awardResource struct {
+	fm *Framework
+}
+
+type awardResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserID      types.String `tfsdk:"user_id"`
+	TeamID      types.String `tfsdk:"team_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Value       types.Int64  `tfsdk:"value"`
+	Category    types.String `tfsdk:"category"`
+	Icon        types.String `tfsdk:"icon"`
+}
+
+type awardResourceModelWithTimeouts struct {
+	awardResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *awardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_award"
+}
+
+func (r *awardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An award of bonus points, or a penalty, to a user or a team (e.g. for a writeup, or a rule violation).\n\nCTFd does not permit updating an award: any change requires a replacement.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the award, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User who receives the award. In teams mode, the award is also given to its team if `team_id` is not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team which receives the award, only accounted in teams mode. At least one of `user_id` and `team_id` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the award, displayed to the players.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the award, e.g. why it has been given.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.Int64Attribute{
+				MarkdownDescription: "Points of the award, negative for a penalty.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Category of the award (e.g. \"Writeups\", \"Penalties\").",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the award in the theme (e.g. \"shield\", \"bug\", \"crown\", \"crosshairs\", \"ban\", \"lightning\", \"skull\", \"brain\", \"code\", \"cowboy\", \"angry\").",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *awardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *awardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_award")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config awardResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.UserID.IsNull() && config.TeamID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Invalid award target",
+			"An award must be given to a user or a team, set at least one of user_id and team_id.",
+		)
+	}
+}
+
+func (r *awardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data awardResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	params := &PostAwardsParams{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Value:       int(data.Value.ValueInt64()),
+		Category:    data.Category.ValueString(),
+		Icon:        data.Icon.ValueString(),
+	}
+	if !data.UserID.IsNull() && !data.UserID.IsUnknown() {
+		params.UserID = utils.Ptr(utils.Atoi(data.UserID.ValueString()))
+	}
+	if !data.TeamID.IsNull() && !data.TeamID.IsUnknown() {
+		params.TeamID = utils.Ptr(utils.Atoi(data.TeamID.ValueString()))
+	}
+
+	// Create award
+	res, _, err := r.fm.Client.PostAwards(ctx, params, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create award, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created an award")
+
+	// Save computed attributes in state, CTFd could have resolved the
+	// team of the user
+	data.awardResourceModel = flattenAward(res)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data awardResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, _, err := r.fm.Client.GetAward(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read award %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Upsert values
+	data.awardResourceModel = flattenAward(res)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data awardResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes require a replacement, so only the timeouts could
+	// have changed
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data awardResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if _, err := r.fm.Client.DeleteAward(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete award %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *awardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
+
+// flattenAward returns the model of an award. CTFd has no user or team 0,
+// so it stands for none.
+func flattenAward(award *api.Award) awardResourceModel {
+	data := awardResourceModel{
+		ID:          types.StringValue(strconv.Itoa(award.ID)),
+		UserID:      types.StringNull(),
+		TeamID:      types.StringNull(),
+		Name:        types.StringValue(award.Name),
+		Description: types.StringValue(""),
+		Value:       types.Int64Value(int64(award.Value)),
+		Category:    types.StringValue(""),
+		Icon:        types.StringValue(award.Icon),
+	}
+	if award.UserID != 0 {
+		data.UserID = types.StringValue(strconv.Itoa(award.UserID))
+	}
+	if award.TeamID != 0 {
+		data.TeamID = types.StringValue(strconv.Itoa(award.TeamID))
+	}
+	if award.Description != nil {
+		data.Description = types.StringValue(*award.Description)
+	}
+	if award.Category != nil {
+		data.Category = types.StringValue(*award.Category)
+	}
+	return data
+}
@@ -0,0 +1,71 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Award_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_user" "player" {
+	name     = "player"
+	email    = "player@ctfer.io"
+	password = "password"
+}
+
+resource "ctfd_award" "writeup" {
+	user_id = ctfd_user.player.id
+	name    = "Best writeup"
+	value   = 100
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("ctfd_award.writeup", "id"),
+					resource.TestCheckResourceAttrPair("ctfd_award.writeup", "user_id", "ctfd_user.player", "id"),
+					resource.TestCheckResourceAttr("ctfd_award.writeup", "category", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "ctfd_award.writeup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_user" "player" {
+	name     = "player"
+	email    = "player@ctfer.io"
+	password = "password"
+}
+
+resource "ctfd_award" "writeup" {
+	user_id     = ctfd_user.player.id
+	name        = "Flag sharing"
+	description = "Shared a flag with another player."
+	value       = -200
+	category    = "Penalties"
+	icon        = "ban"
+}
+
+data "ctfd_awards" "all" {
+	depends_on = [ctfd_award.writeup]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_award.writeup", "value", "-200"),
+					resource.TestCheckResourceAttr("data.ctfd_awards.all", "awards.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -89,6 +90,11 @@ func (data *bracketDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	var state bracketsDataSourceModel
 
+	data.fm.Capabilities.Require(&resp.Diagnostics, path.Empty(), "`ctfd_brackets`", CapabilityBrackets)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	brackets, _, err := data.fm.Client.GetBrackets(ctx, &api.GetBracketsParams{}, WithTracerProvider(data.fm.Tp))
 	if err != nil {
 		resp.Diagnostics.AddError(
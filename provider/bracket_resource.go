@@ -7,6 +7,7 @@ import (
 
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +24,7 @@ var (
 	_ resource.Resource                = (*bracketResource)(nil)
 	_ resource.ResourceWithConfigure   = (*bracketResource)(nil)
 	_ resource.ResourceWithImportState = (*bracketResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*bracketResource)(nil)
 )
 
 func NewBracketResource() resource.Resource {
@@ -40,6 +42,12 @@ type bracketResourceModel struct {
 	Type        types.String `tfsdk:"type"`
 }
 
+type bracketResourceModelWithTimeouts struct {
+	bracketResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *bracketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_bracket"
 }
@@ -76,6 +84,9 @@ func (r *bracketResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -97,16 +108,39 @@ func (r *bracketResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.fm = fm
 }
 
+func (r *bracketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_bracket")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.fm.Capabilities.Require(&resp.Diagnostics, path.Empty(), "`ctfd_bracket`", CapabilityBrackets)
+}
+
 func (r *bracketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data bracketResourceModel
+	var data bracketResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create bracket
 	res, _, err := r.fm.Client.PostBrackets(ctx, &api.PostBracketsParams{
 		Name:        data.Name.ValueString(),
@@ -136,12 +170,20 @@ func (r *bracketResource) Read(ctx context.Context, req resource.ReadRequest, re
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data bracketResourceModel
+	var data bracketResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// XXX cannot get bracket by ID, so we need to query them all
 	brackets, _, err := r.fm.Client.GetBrackets(ctx, &api.GetBracketsParams{}, WithTracerProvider(r.fm.Tp))
 	if err != nil {
@@ -181,12 +223,20 @@ func (r *bracketResource) Update(ctx context.Context, req resource.UpdateRequest
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data bracketResourceModel
+	var data bracketResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update bracket
 	if _, _, err := r.fm.Client.PatchBrackets(ctx, data.ID.ValueString(), &api.PatchBracketsParams{
 		Name:        data.Name.ValueStringPointer(),
@@ -210,12 +260,20 @@ func (r *bracketResource) Delete(ctx context.Context, req resource.DeleteRequest
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data bracketResourceModel
+	var data bracketResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteBrackets(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete bracket %s, got error: %s", data.ID.ValueString(), err))
 		return
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Capability is a feature of CTFd that only exists since a given version.
+type Capability string
+
+const (
+	CapabilityBrackets             Capability = "brackets"
+	CapabilitySolutions            Capability = "solutions"
+	CapabilityHintTitle            Capability = "hint_title"
+	CapabilityChallengeAttribution Capability = "challenge_attribution"
+	CapabilityChallengeLogic       Capability = "challenge_logic"
+	CapabilityChallengePreview     Capability = "challenge_preview"
+)
+
+// capabilitiesSince is the CTFd version each capability was introduced in.
+var capabilitiesSince = map[Capability]*version.Version{
+	CapabilityBrackets:             version.Must(version.NewVersion("3.8.0")),
+	CapabilitySolutions:            version.Must(version.NewVersion("3.8.0")),
+	CapabilityHintTitle:            version.Must(version.NewVersion("3.8.0")),
+	CapabilityChallengeAttribution: version.Must(version.NewVersion("3.7.0")),
+	CapabilityChallengeLogic:       version.Must(version.NewVersion("3.8.0")),
+	CapabilityChallengePreview:     version.Must(version.NewVersion("3.8.0")),
+}
+
+// Capabilities is the set of features the CTFd instance supports.
+// A nil Capabilities, e.g. when the version could not be detected,
+// supports everything.
+type Capabilities struct {
+	Version *version.Version
+}
+
+// DetectCapabilities fetches the version of the CTFd instance and
+// deduces its capabilities.
+// CTFd stores its version in the "ctf_version" config on upgrades,
+// which is only readable by admins.
+func DetectCapabilities(ctx context.Context, client *Client, opts ...Option) (*Capabilities, error) {
+	cfg, _, err := client.GetConfigsByKey(ctx, "ctf_version", opts...)
+	if err != nil {
+		return nil, err
+	}
+	v, err := version.NewVersion(cfg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CTFd version %q: %w", cfg.Value, err)
+	}
+	return &Capabilities{
+		Version: v,
+	}, nil
+}
+
+// Has returns whether the CTFd instance supports the capability.
+func (c *Capabilities) Has(capability Capability) bool {
+	if c == nil {
+		return true
+	}
+	return c.Version.Core().GreaterThanOrEqual(capabilitiesSince[capability])
+}
+
+// Require adds an attribute error if the CTFd instance does not support
+// the capability, required by the feature.
+func (c *Capabilities) Require(diags *diag.Diagnostics, p path.Path, feature string, capability Capability) {
+	if c.Has(capability) {
+		return
+	}
+	diags.AddAttributeError(
+		p,
+		"Unsupported CTFd version",
+		fmt.Sprintf("%s requires CTFd >= %s, got %s.", feature, capabilitiesSince[capability], c.Version),
+	)
+}
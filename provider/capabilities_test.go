@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCapabilities_Has(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		caps *Capabilities
+		want map[Capability]bool
+	}{
+		"undetected": {
+			caps: nil,
+			want: map[Capability]bool{
+				CapabilityBrackets:             true,
+				CapabilityChallengeAttribution: true,
+			},
+		},
+		"3.6.1": {
+			caps: &Capabilities{Version: version.Must(version.NewVersion("3.6.1"))},
+			want: map[Capability]bool{
+				CapabilityBrackets:             false,
+				CapabilityChallengeAttribution: false,
+			},
+		},
+		"3.7.7": {
+			caps: &Capabilities{Version: version.Must(version.NewVersion("3.7.7"))},
+			want: map[Capability]bool{
+				CapabilityBrackets:             false,
+				CapabilityChallengeAttribution: true,
+			},
+		},
+		// Pre-releases have the capabilities of their release
+		"3.8.0-rc1": {
+			caps: &Capabilities{Version: version.Must(version.NewVersion("3.8.0-rc1"))},
+			want: map[Capability]bool{
+				CapabilityBrackets:             true,
+				CapabilityChallengeAttribution: true,
+			},
+		},
+		"4.0.0": {
+			caps: &Capabilities{Version: version.Must(version.NewVersion("4.0.0"))},
+			want: map[Capability]bool{
+				CapabilityBrackets:             true,
+				CapabilityChallengeAttribution: true,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for capability, want := range tt.want {
+				if got := tt.caps.Has(capability); got != want {
+					t.Errorf("%s: got %t, want %t", capability, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCapabilities_Require(t *testing.T) {
+	t.Parallel()
+
+	caps := &Capabilities{Version: version.Must(version.NewVersion("3.7.0"))}
+
+	var diags diag.Diagnostics
+	caps.Require(&diags, path.Root("attribution"), "`ctfd_challenge_standard` attribution", CapabilityChallengeAttribution)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+
+	caps.Require(&diags, path.Empty(), "`ctfd_solution`", CapabilitySolutions)
+	if got := diags.ErrorsCount(); got != 1 {
+		t.Fatalf("got %d errors, want 1", got)
+	}
+	want := "`ctfd_solution` requires CTFd >= 3.8.0, got 3.7.0."
+	if got := diags.Errors()[0].Detail(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		version string
+		want    string
+		wantErr string
+	}{
+		"release": {
+			version: "3.7.4",
+			want:    "3.7.4",
+		},
+		"invalid": {
+			version: "dev",
+			wantErr: `invalid CTFd version "dev"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/configs/ctf_version" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"success":true,"data":{"id":1,"key":"ctf_version","value":%q}}`, tt.version)
+			}))
+			t.Cleanup(srv.Close)
+
+			caps, err := DetectCapabilities(context.Background(), NewClient(srv.URL, "", "", "ctfd_test"))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := caps.Version.String(); got != tt.want {
+				t.Errorf("got version %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckChallengeCapabilities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var sresp resource.SchemaResponse
+	NewChallengeStandardResource().Schema(ctx, resource.SchemaRequest{}, &sresp)
+	typ := sresp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	reqTyp := typ.AttributeTypes["requirements"].(tftypes.Object)
+
+	// config is a challenge configuration with all the attributes depending
+	// on a capability set, all the other ones being null.
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for k, at := range typ.AttributeTypes {
+		attrs[k] = tftypes.NewValue(at, nil)
+	}
+	attrs["attribution"] = tftypes.NewValue(tftypes.String, "ctfer.io")
+	attrs["logic"] = tftypes.NewValue(tftypes.String, "all")
+	attrs["requirements"] = tftypes.NewValue(reqTyp, map[string]tftypes.Value{
+		"behavior":      tftypes.NewValue(tftypes.String, "preview"),
+		"prerequisites": tftypes.NewValue(reqTyp.AttributeTypes["prerequisites"], nil),
+	})
+	config := tfsdk.Config{
+		Schema: sresp.Schema,
+		Raw:    tftypes.NewValue(typ, attrs),
+	}
+
+	tests := map[string]struct {
+		version string
+		want    []path.Path
+	}{
+		"3.6.0": {
+			version: "3.6.0",
+			want:    []path.Path{path.Root("attribution"), path.Root("logic"), path.Root("requirements").AtName("behavior")},
+		},
+		"3.7.0": {
+			version: "3.7.0",
+			want:    []path.Path{path.Root("logic"), path.Root("requirements").AtName("behavior")},
+		},
+		"3.8.0": {
+			version: "3.8.0",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			caps := &Capabilities{Version: version.Must(version.NewVersion(tt.version))}
+			diags := checkChallengeCapabilities(ctx, caps, config, "ctfd_challenge_standard")
+			if got := diags.ErrorsCount(); got != len(tt.want) {
+				t.Fatalf("got %d errors, want %d: %v", got, len(tt.want), diags.Errors())
+			}
+			for i, d := range diags.Errors() {
+				wd, ok := d.(diag.DiagnosticWithPath)
+				if !ok || !wd.Path().Equal(tt.want[i]) {
+					t.Errorf("error %d: got %v, want an error on %s", i, d, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,14 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"slices"
+
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -43,3 +50,64 @@ func FromBehavior(bhv types.String) *string {
 		return utils.Ptr("false") // default value is hidden
 	}
 }
+
+// checkChallengeCapabilities checks the CTFd instance supports the attributes
+// of the challenge configuration, whatever its type.
+func checkChallengeCapabilities(ctx context.Context, caps *Capabilities, config tfsdk.Config, typeName string) (diags diag.Diagnostics) {
+	var attribution, logic, behavior types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("attribution"), &attribution)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("logic"), &logic)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("requirements").AtName("behavior"), &behavior)...)
+	if diags.HasError() {
+		return
+	}
+
+	if !attribution.IsNull() {
+		caps.Require(&diags, path.Root("attribution"), fmt.Sprintf("`%s` attribution", typeName), CapabilityChallengeAttribution)
+	}
+	// "any" is the default logic, thus the behavior of CTFd before logics were introduced
+	if !logic.IsNull() && !logic.IsUnknown() && logic.ValueString() != "any" {
+		caps.Require(&diags, path.Root("logic"), fmt.Sprintf("`%s` logic %q", typeName, logic.ValueString()), CapabilityChallengeLogic)
+	}
+	if behavior.Equal(BehaviorPreview) {
+		caps.Require(&diags, path.Root("requirements").AtName("behavior"), fmt.Sprintf("`%s` preview behavior", typeName), CapabilityChallengePreview)
+	}
+	return
+}
+
+// planWithDefaults plans the attribute to as the union of the values of
+// the attribute from and of the provider defaults.
+func planWithDefaults(ctx context.Context, plan *tfsdk.Plan, from, to path.Path, defaults []string) (diags diag.Diagnostics) {
+	var values types.Set
+	diags.Append(plan.GetAttribute(ctx, from, &values)...)
+	if diags.HasError() {
+		return
+	}
+	if values.IsUnknown() {
+		diags.Append(plan.SetAttribute(ctx, to, types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	all := []types.String{}
+	diags.Append(values.ElementsAs(ctx, &all, false)...)
+	for _, def := range defaults {
+		if v := types.StringValue(def); !slices.Contains(all, v) {
+			all = append(all, v)
+		}
+	}
+	diags.Append(plan.SetAttribute(ctx, to, all)...)
+	return
+}
+
+// withoutDefaults returns the values without the ones injected by the
+// provider defaults, unless they are also configured.
+func withoutDefaults(all, configured []types.String, defaults []string) []types.String {
+	values := make([]types.String, 0, len(all))
+	for _, v := range all {
+		if slices.Contains(defaults, v.ValueString()) && !slices.Contains(configured, v) {
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = (*challengeDynamicResource)(nil)
 	_ resource.ResourceWithConfigure   = (*challengeDynamicResource)(nil)
 	_ resource.ResourceWithImportState = (*challengeDynamicResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*challengeDynamicResource)(nil)
 )
 
 func NewChallengeDynamicResource() resource.Resource {
@@ -45,6 +47,12 @@ type ChallengeDynamicResourceModel struct {
 	Minimum  types.Int64  `tfsdk:"minimum"`
 }
 
+type challengeDynamicResourceModelWithTimeouts struct {
+	ChallengeDynamicResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *challengeDynamicResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_challenge_dynamic"
 }
@@ -53,6 +61,9 @@ func (r *challengeDynamicResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "CTFd is built around the Challenge resource, which contains all the attributes to define a part of the Capture The Flag event.\n\nThis implementation has support of a more dynamic behavior for its scoring through time/solves thus is different from a standard challenge.",
 		Attributes:          ChallengeDynamicResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -74,16 +85,43 @@ func (r *challengeDynamicResource) Configure(ctx context.Context, req resource.C
 	r.fm = fm
 }
 
+func (r *challengeDynamicResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_challenge_dynamic")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkChallengeCapabilities(ctx, r.fm.Capabilities, req.Config, "ctfd_challenge_dynamic")...)
+
+	// Merge the provider defaults
+	resp.Diagnostics.Append(planWithDefaults(ctx, &resp.Plan, path.Root("tags"), path.Root("tags_all"), r.fm.DefaultTags)...)
+	resp.Diagnostics.Append(planWithDefaults(ctx, &resp.Plan, path.Root("topics"), path.Root("topics_all"), r.fm.DefaultTopics)...)
+}
+
 func (r *challengeDynamicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeDynamicResourceModel
+	var data challengeDynamicResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create Challenge
 	reqs := (*api.Requirements)(nil)
 	if data.Requirements != nil {
@@ -129,8 +167,8 @@ func (r *challengeDynamicResource) Create(ctx context.Context, req resource.Crea
 	data.ID = types.StringValue(strconv.Itoa(res.ID))
 
 	// Create tags
-	challTags := make([]types.String, 0, len(data.Tags))
-	for _, tag := range data.Tags {
+	challTags := make([]types.String, 0, len(data.TagsAll))
+	for _, tag := range data.TagsAll {
 		_, _, err := r.fm.Client.PostTags(ctx, &api.PostTagsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Value:     tag.ValueString(),
@@ -144,13 +182,11 @@ func (r *challengeDynamicResource) Create(ctx context.Context, req resource.Crea
 		}
 		challTags = append(challTags, tag)
 	}
-	if data.Tags != nil {
-		data.Tags = challTags
-	}
+	data.TagsAll = challTags
 
 	// Create topics
-	challTopics := make([]types.String, 0, len(data.Topics))
-	for _, topic := range data.Topics {
+	challTopics := make([]types.String, 0, len(data.TopicsAll))
+	for _, topic := range data.TopicsAll {
 		_, _, err := r.fm.Client.PostTopics(ctx, &api.PostTopicsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Type:      "challenge",
@@ -165,9 +201,7 @@ func (r *challengeDynamicResource) Create(ctx context.Context, req resource.Crea
 		}
 		challTopics = append(challTopics, topic)
 	}
-	if data.Topics != nil {
-		data.Topics = challTopics
-	}
+	data.TopicsAll = challTopics
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -179,14 +213,27 @@ func (r *challengeDynamicResource) Read(ctx context.Context, req resource.ReadRe
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeDynamicResourceModel
+	var data challengeDynamicResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tags, topics := data.Tags, data.Topics
 	data.Read(ctx, r.fm.Client, resp.Diagnostics, WithTracerProvider(r.fm.Tp))
 
+	// Don't show the provider defaults as drift
+	data.Tags = withoutDefaults(data.TagsAll, tags, r.fm.DefaultTags)
+	data.Topics = withoutDefaults(data.TopicsAll, topics, r.fm.DefaultTopics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -197,12 +244,20 @@ func (r *challengeDynamicResource) Update(ctx context.Context, req resource.Upda
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeDynamicResourceModel
+	var data challengeDynamicResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var dataState ChallengeDynamicResourceModel
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+	var dataState challengeDynamicResourceModelWithTimeouts
 	req.State.Get(ctx, &dataState)
 
 	// Patch direct attributes
@@ -261,8 +316,8 @@ func (r *challengeDynamicResource) Update(ctx context.Context, req resource.Upda
 			return
 		}
 	}
-	tags := make([]types.String, 0, len(data.Tags))
-	for _, tag := range data.Tags {
+	tags := make([]types.String, 0, len(data.TagsAll))
+	for _, tag := range data.TagsAll {
 		_, _, err := r.fm.Client.PostTags(ctx, &api.PostTagsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Value:     tag.ValueString(),
@@ -276,9 +331,7 @@ func (r *challengeDynamicResource) Update(ctx context.Context, req resource.Upda
 		}
 		tags = append(tags, tag)
 	}
-	if data.Tags != nil {
-		data.Tags = tags
-	}
+	data.TagsAll = tags
 
 	// Update its topics (drop them all, create new ones)
 	challTopics, _, err := r.fm.Client.GetChallengeTopics(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
@@ -301,8 +354,8 @@ func (r *challengeDynamicResource) Update(ctx context.Context, req resource.Upda
 			return
 		}
 	}
-	topics := make([]types.String, 0, len(data.Topics))
-	for _, topic := range data.Topics {
+	topics := make([]types.String, 0, len(data.TopicsAll))
+	for _, topic := range data.TopicsAll {
 		_, _, err := r.fm.Client.PostTopics(ctx, &api.PostTopicsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Type:      "challenge",
@@ -317,9 +370,7 @@ func (r *challengeDynamicResource) Update(ctx context.Context, req resource.Upda
 		}
 		topics = append(topics, topic)
 	}
-	if data.Topics != nil {
-		data.Topics = topics
-	}
+	data.TopicsAll = topics
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -331,12 +382,20 @@ func (r *challengeDynamicResource) Delete(ctx context.Context, req resource.Dele
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeDynamicResourceModel
+	var data challengeDynamicResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteChallenge(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete challenge, got error: %s", err))
 		return
@@ -414,6 +473,7 @@ func (chall *ChallengeDynamicResourceModel) Read(ctx context.Context, client *Cl
 	for _, tag := range resTags {
 		chall.Tags = append(chall.Tags, types.StringValue(tag.Value))
 	}
+	chall.TagsAll = chall.Tags
 
 	// => Topics
 	resTopics, _, err := client.GetChallengeTopics(ctx, strconv.Itoa(id), opts...)
@@ -428,6 +488,7 @@ func (chall *ChallengeDynamicResourceModel) Read(ctx context.Context, client *Cl
 	for _, topic := range resTopics {
 		chall.Topics = append(chall.Topics, types.StringValue(topic.Value))
 	}
+	chall.TopicsAll = chall.Topics
 }
 
 var (
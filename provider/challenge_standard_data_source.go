@@ -108,6 +108,16 @@ func (data *challengeStandardDataSource) Schema(ctx context.Context, req datasou
 							ElementType:         types.StringType,
 							Computed:            true,
 						},
+						"tags_all": schema.SetAttribute{
+							MarkdownDescription: "All the challenge tags, including the `default_tags` of the provider.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"topics_all": schema.SetAttribute{
+							MarkdownDescription: "All the challenge topics, including the `default_topics` of the provider.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
 					},
 				},
 			},
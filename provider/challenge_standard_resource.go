@@ -8,6 +8,7 @@ import (
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = (*challengeStandardResource)(nil)
 	_ resource.ResourceWithConfigure   = (*challengeStandardResource)(nil)
 	_ resource.ResourceWithImportState = (*challengeStandardResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*challengeStandardResource)(nil)
 )
 
 func NewChallengeStandardResource() resource.Resource {
@@ -56,7 +58,15 @@ type ChallengeStandardResourceModel struct {
 	Next           types.Int64                   `tfsdk:"next"`
 	Requirements   *RequirementsSubresourceModel `tfsdk:"requirements"`
 	Tags           []types.String                `tfsdk:"tags"`
+	TagsAll        []types.String                `tfsdk:"tags_all"`
 	Topics         []types.String                `tfsdk:"topics"`
+	TopicsAll      []types.String                `tfsdk:"topics_all"`
+}
+
+type challengeStandardResourceModelWithTimeouts struct {
+	ChallengeStandardResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *challengeStandardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,6 +77,9 @@ func (r *challengeStandardResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "CTFd is built around the Challenge resource, which contains all the attributes to define a part of the Capture The Flag event.\n\nIt is the first historic implementation of its kind, with basic functionalities.",
 		Attributes:          ChallengeStandardResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -88,16 +101,43 @@ func (r *challengeStandardResource) Configure(ctx context.Context, req resource.
 	r.fm = fm
 }
 
+func (r *challengeStandardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_challenge_standard")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkChallengeCapabilities(ctx, r.fm.Capabilities, req.Config, "ctfd_challenge_standard")...)
+
+	// Merge the provider defaults
+	resp.Diagnostics.Append(planWithDefaults(ctx, &resp.Plan, path.Root("tags"), path.Root("tags_all"), r.fm.DefaultTags)...)
+	resp.Diagnostics.Append(planWithDefaults(ctx, &resp.Plan, path.Root("topics"), path.Root("topics_all"), r.fm.DefaultTopics)...)
+}
+
 func (r *challengeStandardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeStandardResourceModel
+	var data challengeStandardResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create Challenge
 	reqs := (*api.Requirements)(nil)
 	if data.Requirements != nil {
@@ -140,8 +180,8 @@ func (r *challengeStandardResource) Create(ctx context.Context, req resource.Cre
 	data.ID = types.StringValue(strconv.Itoa(res.ID))
 
 	// Create tags
-	challTags := make([]types.String, 0, len(data.Tags))
-	for _, tag := range data.Tags {
+	challTags := make([]types.String, 0, len(data.TagsAll))
+	for _, tag := range data.TagsAll {
 		_, _, err := r.fm.Client.PostTags(ctx, &api.PostTagsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Value:     tag.ValueString(),
@@ -155,13 +195,11 @@ func (r *challengeStandardResource) Create(ctx context.Context, req resource.Cre
 		}
 		challTags = append(challTags, tag)
 	}
-	if data.Tags != nil {
-		data.Tags = challTags
-	}
+	data.TagsAll = challTags
 
 	// Create topics
-	challTopics := make([]types.String, 0, len(data.Topics))
-	for _, topic := range data.Topics {
+	challTopics := make([]types.String, 0, len(data.TopicsAll))
+	for _, topic := range data.TopicsAll {
 		_, _, err := r.fm.Client.PostTopics(ctx, &api.PostTopicsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Type:      "challenge",
@@ -176,9 +214,7 @@ func (r *challengeStandardResource) Create(ctx context.Context, req resource.Cre
 		}
 		challTopics = append(challTopics, topic)
 	}
-	if data.Topics != nil {
-		data.Topics = challTopics
-	}
+	data.TopicsAll = challTopics
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -190,14 +226,27 @@ func (r *challengeStandardResource) Read(ctx context.Context, req resource.ReadR
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeStandardResourceModel
+	var data challengeStandardResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tags, topics := data.Tags, data.Topics
 	data.Read(ctx, r.fm.Client, resp.Diagnostics, WithTracerProvider(r.fm.Tp))
 
+	// Don't show the provider defaults as drift
+	data.Tags = withoutDefaults(data.TagsAll, tags, r.fm.DefaultTags)
+	data.Topics = withoutDefaults(data.TopicsAll, topics, r.fm.DefaultTopics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -208,12 +257,20 @@ func (r *challengeStandardResource) Update(ctx context.Context, req resource.Upd
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeStandardResourceModel
+	var data challengeStandardResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var dataState ChallengeStandardResourceModel
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+	var dataState challengeStandardResourceModelWithTimeouts
 	req.State.Get(ctx, &dataState)
 
 	// Patch direct attributes
@@ -269,8 +326,8 @@ func (r *challengeStandardResource) Update(ctx context.Context, req resource.Upd
 			return
 		}
 	}
-	tags := make([]types.String, 0, len(data.Tags))
-	for _, tag := range data.Tags {
+	tags := make([]types.String, 0, len(data.TagsAll))
+	for _, tag := range data.TagsAll {
 		_, _, err := r.fm.Client.PostTags(ctx, &api.PostTagsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Value:     tag.ValueString(),
@@ -284,9 +341,7 @@ func (r *challengeStandardResource) Update(ctx context.Context, req resource.Upd
 		}
 		tags = append(tags, tag)
 	}
-	if data.Tags != nil {
-		data.Tags = tags
-	}
+	data.TagsAll = tags
 
 	// Update its topics (drop them all, create new ones)
 	challTopics, _, err := r.fm.Client.GetChallengeTopics(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
@@ -309,8 +364,8 @@ func (r *challengeStandardResource) Update(ctx context.Context, req resource.Upd
 			return
 		}
 	}
-	topics := make([]types.String, 0, len(data.Topics))
-	for _, topic := range data.Topics {
+	topics := make([]types.String, 0, len(data.TopicsAll))
+	for _, topic := range data.TopicsAll {
 		_, _, err := r.fm.Client.PostTopics(ctx, &api.PostTopicsParams{
 			Challenge: utils.Atoi(data.ID.ValueString()),
 			Type:      "challenge",
@@ -325,9 +380,7 @@ func (r *challengeStandardResource) Update(ctx context.Context, req resource.Upd
 		}
 		topics = append(topics, topic)
 	}
-	if data.Topics != nil {
-		data.Topics = topics
-	}
+	data.TopicsAll = topics
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -339,12 +392,20 @@ func (r *challengeStandardResource) Delete(ctx context.Context, req resource.Del
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data ChallengeStandardResourceModel
+	var data challengeStandardResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteChallenge(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete challenge, got error: %s", err))
 		return
@@ -419,6 +480,7 @@ func (chall *ChallengeStandardResourceModel) Read(ctx context.Context, client *C
 	for _, tag := range resTags {
 		chall.Tags = append(chall.Tags, types.StringValue(tag.Value))
 	}
+	chall.TagsAll = chall.Tags
 
 	// => Topics
 	resTopics, _, err := client.GetChallengeTopics(ctx, strconv.Itoa(id), opts...)
@@ -433,6 +495,7 @@ func (chall *ChallengeStandardResourceModel) Read(ctx context.Context, client *C
 	for _, topic := range resTopics {
 		chall.Topics = append(chall.Topics, types.StringValue(topic.Value))
 	}
+	chall.TopicsAll = chall.Topics
 }
 
 var (
@@ -552,5 +615,15 @@ var (
 			Computed:            true,
 			Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
 		},
+		"tags_all": schema.SetAttribute{
+			MarkdownDescription: "All the challenge tags, including the `default_tags` of the provider.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"topics_all": schema.SetAttribute{
+			MarkdownDescription: "All the challenge topics, including the `default_topics` of the provider.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
 	}
 )
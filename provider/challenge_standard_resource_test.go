@@ -41,6 +41,8 @@ resource "ctfd_challenge_standard" "http" {
 					// of CTFd is "any" meaning that any flag validates the challenge, which keeps
 					// consistent behavior with previous versions of CTFd.
 					resource.TestCheckResourceAttr("ctfd_challenge_standard.http", "logic", "any"),
+					// Without provider default tags, all tags are the configured ones.
+					resource.TestCheckResourceAttr("ctfd_challenge_standard.http", "tags_all.#", "1"),
 				),
 			},
 			// ImportState testing
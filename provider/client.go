@@ -8,37 +8,196 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"slices"
+	"sync"
 
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-var apiTransport = api.WithTransport(otelhttp.NewTransport(http.DefaultTransport))
+// defaultTransport is used when no transport is provided, for instance
+// when the Client is used outside of the provider.
+var defaultTransport = sync.OnceValue(func() http.RoundTripper {
+	return NewTransport(nil, nil, nil)
+})
 
-func apiOptions(ctx context.Context) []api.Option {
-	return []api.Option{
-		api.WithContext(ctx),
-		apiTransport,
+// apiTransport builds the transport of an API call given its options.
+func apiTransport(opts ...Option) http.RoundTripper {
+	o := getOptions(opts...)
+
+	tp := o.transport
+	if tp == nil {
+		tp = defaultTransport()
+	}
+	if o.timeout > 0 {
+		tp = &timeoutTransport{
+			next:    tp,
+			timeout: o.timeout,
+		}
+	}
+	// Log every attempt, such that retries are visible
+	tp = &logTransport{
+		next: tp,
+	}
+	// Every attempt complies with the rate, retries included
+	if o.limiter != nil {
+		tp = &rateTransport{
+			next:    tp,
+			limiter: o.limiter,
+		}
+	}
+	if o.retry != nil {
+		tp = &retryTransport{
+			next:   tp,
+			policy: *o.retry,
+		}
+	}
+	// Retries hold the concurrency slot of the request, such that they
+	// don't compete with new requests on an already overloaded CTFd
+	if o.limiter != nil {
+		tp = &limitTransport{
+			next:    tp,
+			limiter: o.limiter,
+		}
 	}
+	if o.notFound {
+		tp = &notFoundTransport{
+			next: tp,
+		}
+	}
+	return tp
 }
 
+// GetNonceAndSession fetches a fresh nonce and session from the setup page.
+// It is not delegated to the underlying client, as the latter sets its
+// transport on http.DefaultClient, on which concurrent calls would race.
 func GetNonceAndSession(ctx context.Context, url string, opts ...Option) (nonce, session string, err error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return api.GetNonceAndSession(url, apiOptions(ctx)...)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/setup", nil)
+	if err != nil {
+		return "", "", err
+	}
+	sub := &http.Client{
+		Transport: apiTransport(opts...),
+	}
+	res, err := sub.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", "", err
+	}
+	match := nonceRegex.Find(b)
+	if match == nil {
+		return "", "", errors.New("nonce not found")
+	}
+	for _, cookie := range res.Cookies() {
+		if cookie.Name == "session" {
+			return string(match), cookie.Value, nil
+		}
+	}
+	return "", "", errors.New("session cookie not found")
 }
 
 type Client struct {
-	sub *api.Client
+	// opts are applied to every call, before the call-specific ones.
+	opts []Option
+
+	url    string
+	apiKey string
+
+	// mu guards the session, as it could be renewed while other operations
+	// are running.
+	mu sync.RWMutex
+	// nonce and session are the last known ones, as the underlying
+	// client does not expose them.
+	nonce   string
+	session string
+	// gen is incremented every time the session is renewed.
+	gen uint64
+	// login is the parameters of the last successful Login, used to login
+	// again once the session expired.
+	login *api.LoginParams
+	// cache stores the renewed sessions, if any.
+	cache *sessionCache
+	// anonymous rejects the API calls, as the client has no credentials.
+	anonymous bool
 }
 
-func NewClient(url, nonce, session, apiKey string) *Client {
+func NewClient(url, nonce, session, apiKey string, opts ...Option) *Client {
 	return &Client{
-		sub: api.NewClient(url, nonce, session, apiKey),
+		opts:    opts,
+		url:     url,
+		apiKey:  apiKey,
+		nonce:   nonce,
+		session: session,
+	}
+}
+
+// NewAnonymousClient creates a client without credentials, which could only
+// setup the CTFd instance.
+func NewAnonymousClient(url string, opts ...Option) *Client {
+	cli := NewClient(url, "", "", "", opts...)
+	cli.anonymous = true
+	return cli
+}
+
+// Session returns the last known nonce and session of the client,
+// e.g. after a Login.
+func (cli *Client) Session() (nonce, session string) {
+	cli.mu.RLock()
+	defer cli.mu.RUnlock()
+
+	return cli.nonce, cli.session
+}
+
+// newSub returns the underlying client of a call, authenticated with the
+// last known nonce and session. A new one is used for every call, as the
+// underlying client keeps the transport of its last call, on which concurrent
+// calls would race.
+func (cli *Client) newSub() *api.Client {
+	cli.mu.RLock()
+	defer cli.mu.RUnlock()
+
+	return api.NewClient(cli.url, cli.nonce, cli.session, cli.apiKey)
+}
+
+func (cli *Client) apiOptions(ctx context.Context, opts ...Option) []api.Option {
+	opts = slices.Concat(cli.opts, opts)
+
+	// Renew the session if it expires, which only applies once logged in
+	// as API keys do not expire this way.
+	cli.mu.RLock()
+	renew := cli.apiKey == "" && cli.login != nil
+	cli.mu.RUnlock()
+
+	tp := apiTransport(opts...)
+	if renew {
+		tp = &reloginTransport{
+			next: tp,
+			cli:  cli,
+		}
+	}
+	if cli.anonymous {
+		tp = &anonymousTransport{}
+	}
+	return []api.Option{
+		api.WithContext(ctx),
+		api.WithTransport(withReadOnly(tp, opts...)),
 	}
 }
 
@@ -46,7 +205,144 @@ func (cli *Client) Login(ctx context.Context, params *api.LoginParams, opts ...O
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.Login(params, apiOptions(ctx)...)
+	rec := &sessionRecorder{
+		next: apiTransport(slices.Concat(cli.opts, opts)...),
+	}
+	if err := cli.newSub().Login(params, api.WithContext(ctx), api.WithTransport(rec)); err != nil {
+		return err
+	}
+
+	cli.mu.Lock()
+	defer cli.mu.Unlock()
+
+	if rec.nonce != "" {
+		cli.nonce = rec.nonce
+	}
+	if rec.session != "" {
+		cli.session = rec.session
+	}
+	cli.gen++
+	cli.login = params
+	return nil
+}
+
+// region setup
+
+// IsSetup returns whether the CTFd instance completed its setup, i.e. if
+// /setup redirects rather than serving the setup form.
+func (cli *Client) IsSetup(ctx context.Context, opts ...Option) (bool, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.url+"/setup", nil)
+	if err != nil {
+		return false, err
+	}
+	opts = slices.Concat(cli.opts, opts)
+	sub := &http.Client{
+		Transport: withReadOnly(apiTransport(opts...), opts...),
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	res, err := sub.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	switch {
+	case res.StatusCode == http.StatusOK:
+		return false, nil
+	case res.StatusCode >= 300 && res.StatusCode < 400:
+		return true, nil
+	default:
+		return false, fmt.Errorf("CTFd responded to /setup with status code %d", res.StatusCode)
+	}
+}
+
+// Setup completes the setup of the CTFd instance. It uses a fresh session,
+// as the setup form is served to anonymous visitors.
+func (cli *Client) Setup(ctx context.Context, params *api.SetupParams, opts ...Option) error {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	opts = slices.Concat(cli.opts, opts)
+	nonce, session, err := GetNonceAndSession(ctx, cli.url, opts...)
+	if err != nil {
+		return err
+	}
+	sub := api.NewClient(cli.url, nonce, session, "")
+	return sub.Setup(params, api.WithContext(ctx), api.WithTransport(withReadOnly(apiTransport(opts...), opts...)))
+}
+
+// NewSession returns a new client of the same CTFd instance and options,
+// logged in with the given credentials.
+func (cli *Client) NewSession(ctx context.Context, params *api.LoginParams, opts ...Option) (*Client, error) {
+	nonce, session, err := GetNonceAndSession(ctx, cli.url, slices.Concat(cli.opts, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	sub := NewClient(cli.url, nonce, session, "", cli.opts...)
+	if err := sub.Login(ctx, params, opts...); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// WithAPIKey returns a new client of the same CTFd instance and options,
+// authenticated with the given API key.
+func (cli *Client) WithAPIKey(apiKey string) *Client {
+	return NewClient(cli.url, "", "", apiKey, cli.opts...)
+}
+
+// region awards
+
+func (cli *Client) GetAwards(ctx context.Context, params *api.GetAwardsParams, opts ...Option) ([]*api.Award, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetAwards(params, cli.apiOptions(ctx, opts...)...)
+}
+
+// PostAwardsParams are the parameters of an award. Contrary to the
+// underlying client ones, it could target a team.
+type PostAwardsParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+	Icon        string `json:"icon"`
+	UserID      *int   `json:"user_id,omitempty"`
+	TeamID      *int   `json:"team_id,omitempty"`
+	Value       int    `json:"value"`
+}
+
+func (cli *Client) PostAwards(ctx context.Context, params *PostAwardsParams, opts ...Option) (*api.Award, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	award := &api.Award{}
+	meta, err := cli.newSub().Post("/awards", params, &award, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return award, meta, nil
+}
+
+func (cli *Client) GetAward(ctx context.Context, id string, opts ...Option) (*api.Award, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetAward(id, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) DeleteAward(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().DeleteAward(id, cli.apiOptions(ctx, opts...)...)
 }
 
 // region brackets
@@ -55,28 +351,28 @@ func (cli *Client) GetBrackets(ctx context.Context, params *api.GetBracketsParam
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetBrackets(params, apiOptions(ctx)...)
+	return cli.newSub().GetBrackets(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PostBrackets(ctx context.Context, params *api.PostBracketsParams, opts ...Option) (*api.Bracket, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostBrackets(params, apiOptions(ctx)...)
+	return cli.newSub().PostBrackets(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PatchBrackets(ctx context.Context, id string, params *api.PatchBracketsParams, opts ...Option) (*api.Bracket, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PatchBrackets(utils.Atoi(id), params, apiOptions(ctx)...)
+	return cli.newSub().PatchBrackets(utils.Atoi(id), params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteBrackets(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteBrackets(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().DeleteBrackets(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 // region challenges
@@ -85,49 +381,89 @@ func (cli *Client) GetChallenges(ctx context.Context, params *api.GetChallengesP
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetChallenges(params, apiOptions(ctx)...)
+	return cli.newSub().GetChallenges(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PostChallenges(ctx context.Context, params *api.PostChallengesParams, opts ...Option) (*api.Challenge, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostChallenges(params, apiOptions(ctx)...)
+	return cli.newSub().PostChallenges(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PatchChallenge(ctx context.Context, id string, params *api.PatchChallengeParams, opts ...Option) (*api.Challenge, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PatchChallenge(utils.Atoi(id), params, apiOptions(ctx)...)
+	return cli.newSub().PatchChallenge(utils.Atoi(id), params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetChallengeTags(ctx context.Context, id string, opts ...Option) ([]*api.Tag, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetChallengeTags(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().GetChallengeTags(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetChallengeRequirements(ctx context.Context, id string, opts ...Option) (*api.Requirements, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetChallengeRequirements(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().GetChallengeRequirements(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetChallengeFiles(ctx context.Context, id string, opts ...Option) ([]*api.File, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetChallengeFiles(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().GetChallengeFiles(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetChallengeHints(ctx context.Context, id string, opts ...Option) ([]*api.Hint, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetChallengeHints(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().GetChallengeHints(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
+}
+
+// region configs
+
+func (cli *Client) GetConfigs(ctx context.Context, params *api.GetConfigsParams, opts ...Option) ([]*api.Config, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetConfigs(params, cli.apiOptions(ctx, opts...)...)
+}
+
+// PatchConfigs sets the values of the config keys, creating the missing
+// ones. Contrary to the underlying client, it is not limited to the keys
+// known by go-ctfd, such that plugins ones could be set too.
+func (cli *Client) PatchConfigs(ctx context.Context, values map[string]string, opts ...Option) (*api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().Patch("/configs", values, nil, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) DeleteConfigsByKey(ctx context.Context, key string, opts ...Option) (*api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().DeleteConfigsByKey(key, cli.apiOptions(ctx, opts...)...)
+}
+
+// GetConfigsByKey returns the config of the given key. Contrary to the
+// underlying client, it is typed as CTFd stores all values as strings.
+func (cli *Client) GetConfigsByKey(ctx context.Context, key string, opts ...Option) (*api.Config, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	cfg := &api.Config{}
+	meta, err := cli.newSub().Get("/configs/"+key, nil, cfg, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return cfg, meta, nil
 }
 
 // region tags
@@ -136,28 +472,28 @@ func (cli *Client) PostTags(ctx context.Context, params *api.PostTagsParams, opt
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostTags(params, apiOptions(ctx)...)
+	return cli.newSub().PostTags(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteTag(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteTag(id, apiOptions(ctx)...)
+	return cli.newSub().DeleteTag(id, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteChallenge(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteChallenge(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().DeleteChallenge(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetChallenge(ctx context.Context, id string, opts ...Option) (*api.Challenge, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetChallenge(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().GetChallenge(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 // region topics
@@ -166,21 +502,62 @@ func (cli *Client) PostTopics(ctx context.Context, params *api.PostTopicsParams,
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostTopics(params, apiOptions(ctx)...)
+	return cli.newSub().PostTopics(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteTopic(ctx context.Context, params *api.DeleteTopicArgs, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteTopic(params, apiOptions(ctx)...)
+	return cli.newSub().DeleteTopic(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetChallengeTopics(ctx context.Context, id string, opts ...Option) ([]*api.Topic, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetChallengeTopics(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().GetChallengeTopics(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
+}
+
+// region fields
+
+// FieldEntry is the value of a custom field for a user or a team. It is
+// either a string or a boolean, depending on the field type.
+type FieldEntry struct {
+	FieldID int `json:"field_id"`
+	Value   any `json:"value"`
+}
+
+type patchFieldsParams struct {
+	Fields []FieldEntry `json:"fields"`
+}
+
+func (cli *Client) PostConfigFields(ctx context.Context, params *api.PostConfigFieldsParams, opts ...Option) (*api.ConfigField, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().PostConfigFields(params, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) GetConfigsField(ctx context.Context, id string, opts ...Option) (*api.ConfigField, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetConfigsField(id, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) PatchConfigsField(ctx context.Context, id string, params *api.PatchConfigsFieldParams, opts ...Option) (*api.ConfigField, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().PatchConfigsField(id, params, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) DeleteConfigsField(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().DeleteConfigsField(id, cli.apiOptions(ctx, opts...)...)
 }
 
 // region files
@@ -189,28 +566,78 @@ func (cli *Client) PostFiles(ctx context.Context, params *api.PostFilesParams, o
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostFiles(params, apiOptions(ctx)...)
+	return cli.newSub().PostFiles(params, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) GetFiles(ctx context.Context, params *api.GetFilesParams, opts ...Option) ([]*api.File, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetFiles(params, cli.apiOptions(ctx, opts...)...)
+}
+
+// PostPageFiles uploads files of type "page", e.g. the theme images, as
+// the underlying client only uploads standard and challenge files.
+func (cli *Client) PostPageFiles(ctx context.Context, files []*api.InputFile, opts ...Option) ([]*api.File, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	nonce, _ := cli.Session()
+
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	for _, file := range files {
+		fw, err := w.CreateFormFile("file", file.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := fw.Write(file.Content); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := w.WriteField("nonce", nonce); err != nil {
+		return nil, nil, err
+	}
+	if err := w.WriteField("type", "page"); err != nil {
+		return nil, nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "/files", &b)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	res := []*api.File{}
+	meta, err := cli.newSub().Call(req, &res, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return res, meta, nil
 }
 
 func (cli *Client) GetFile(ctx context.Context, id string, opts ...Option) (*api.File, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetFile(id, apiOptions(ctx)...)
+	return cli.newSub().GetFile(id, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetFileContent(ctx context.Context, file *api.File, opts ...Option) ([]byte, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetFileContent(file, apiOptions(ctx)...)
+	return cli.newSub().GetFileContent(file, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteFile(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteFile(id, apiOptions(ctx)...)
+	return cli.newSub().DeleteFile(id, cli.apiOptions(ctx, opts...)...)
 }
 
 // region flags
@@ -219,28 +646,28 @@ func (cli *Client) PostFlags(ctx context.Context, params *api.PostFlagsParams, o
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostFlags(params, apiOptions(ctx)...)
+	return cli.newSub().PostFlags(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetFlag(ctx context.Context, id string, opts ...Option) (*api.Flag, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetFlag(id, apiOptions(ctx)...)
+	return cli.newSub().GetFlag(id, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PatchFlag(ctx context.Context, id string, params *api.PatchFlagParams, opts ...Option) (*api.Flag, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PatchFlag(id, params, apiOptions(ctx)...)
+	return cli.newSub().PatchFlag(id, params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteFlag(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteFlag(id, apiOptions(ctx)...)
+	return cli.newSub().DeleteFlag(id, cli.apiOptions(ctx, opts...)...)
 }
 
 // region hints
@@ -249,28 +676,109 @@ func (cli *Client) PostHints(ctx context.Context, params *api.PostHintsParams, o
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostHints(params, apiOptions(ctx)...)
+	return cli.newSub().PostHints(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetHint(ctx context.Context, id string, params *api.GetHintParams, opts ...Option) (*api.Hint, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetHint(id, params, apiOptions(ctx)...)
+	return cli.newSub().GetHint(id, params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PatchHint(ctx context.Context, id string, params *api.PatchHintsParams, opts ...Option) (*api.Hint, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PatchHint(id, params, apiOptions(ctx)...)
+	return cli.newSub().PatchHint(id, params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteHint(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteHint(id, apiOptions(ctx)...)
+	return cli.newSub().DeleteHint(id, cli.apiOptions(ctx, opts...)...)
+}
+
+// region notifications
+
+func (cli *Client) GetNotification(ctx context.Context, id string, opts ...Option) (*api.Notification, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetNotification(id, cli.apiOptions(ctx, opts...)...)
+}
+
+// PostNotifications sends a notification. Contrary to the underlying
+// client, it sends its user or team target.
+func (cli *Client) PostNotifications(ctx context.Context, params *api.PostNotificationsParams, opts ...Option) (*api.Notification, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	notif := &api.Notification{}
+	meta, err := cli.newSub().Post("/notifications", &struct {
+		Content string `json:"content"`
+		Sound   bool   `json:"sound"`
+		Title   string `json:"title"`
+		Type    string `json:"type"`
+		UserID  *int   `json:"user_id,omitempty"`
+		TeamID  *int   `json:"team_id,omitempty"`
+	}{
+		Content: params.Content,
+		Sound:   params.Sound,
+		Title:   params.Title,
+		Type:    params.Type,
+		UserID:  params.UserID,
+		TeamID:  params.TeamID,
+	}, &notif, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return notif, meta, nil
+}
+
+func (cli *Client) DeleteNotification(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().DeleteNotification(id, cli.apiOptions(ctx, opts...)...)
+}
+
+// region pages
+
+func (cli *Client) GetPages(ctx context.Context, params *api.GetPagesParams, opts ...Option) ([]*api.Page, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetPages(params, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) PostPages(ctx context.Context, params *api.PostPagesParams, opts ...Option) (*api.Page, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().PostPages(params, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) GetPage(ctx context.Context, id string, opts ...Option) (*api.Page, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().GetPage(id, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) PatchPage(ctx context.Context, id string, params *api.PatchPageParams, opts ...Option) (*api.Page, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().PatchPage(id, params, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) DeletePage(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().DeletePage(id, cli.apiOptions(ctx, opts...)...)
 }
 
 // region solutions
@@ -279,121 +787,245 @@ func (cli *Client) PostSolutions(ctx context.Context, params *api.PostSolutionsP
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostSolutions(params, apiOptions(ctx)...)
+	return cli.newSub().PostSolutions(params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetSolutions(ctx context.Context, id string, params *api.GetSolutionsParams, opts ...Option) (*api.Solution, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetSolutions(utils.Atoi(id), params, apiOptions(ctx)...)
+	return cli.newSub().GetSolutions(utils.Atoi(id), params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PatchSolutions(ctx context.Context, id string, params *api.PatchSolutionsParams, opts ...Option) (*api.Solution, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PatchSolutions(utils.Atoi(id), params, apiOptions(ctx)...)
+	return cli.newSub().PatchSolutions(utils.Atoi(id), params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteSolutions(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteSolutions(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().DeleteSolutions(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 // region teams
 
-func (cli *Client) GetTeams(ctx context.Context, params *api.GetTeamsParams, opts ...Option) ([]*api.Team, *api.MetaResponse, error) {
+// Team is a CTFd team with its custom fields values, as the underlying
+// client could not decode them.
+type Team struct {
+	api.Team
+
+	Fields []FieldEntry `json:"fields"`
+}
+
+func (cli *Client) GetTeams(ctx context.Context, params *api.GetTeamsParams, opts ...Option) ([]*Team, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	teams := []*Team{}
+	meta, err := cli.newSub().Get("/teams", params, &teams, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return teams, meta, nil
+}
+
+func (cli *Client) PostTeams(ctx context.Context, params *api.PostTeamsParams, opts ...Option) (*Team, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetTeams(params, apiOptions(ctx)...)
+	team := &Team{}
+	meta, err := cli.newSub().Post("/teams", params, &team, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return team, meta, nil
 }
 
-func (cli *Client) PostTeams(ctx context.Context, params *api.PostTeamsParams, opts ...Option) (*api.Team, *api.MetaResponse, error) {
+func (cli *Client) PatchTeam(ctx context.Context, id string, params *api.PatchTeamsParams, opts ...Option) (*Team, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostTeams(params, apiOptions(ctx)...)
+	team := &Team{}
+	meta, err := cli.newSub().Patch(fmt.Sprintf("/teams/%d", utils.Atoi(id)), params, &team, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return team, meta, nil
 }
 
-func (cli *Client) PatchTeam(ctx context.Context, id string, params *api.PatchTeamsParams, opts ...Option) (*api.Team, *api.MetaResponse, error) {
+// PatchTeamFields sets the custom fields values of a team. The values of
+// the other fields are kept.
+func (cli *Client) PatchTeamFields(ctx context.Context, id string, fields []FieldEntry, opts ...Option) (*Team, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PatchTeam(utils.Atoi(id), params, apiOptions(ctx)...)
+	team := &Team{}
+	meta, err := cli.newSub().Patch(fmt.Sprintf("/teams/%d", utils.Atoi(id)), &patchFieldsParams{
+		Fields: fields,
+	}, &team, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return team, meta, nil
 }
 
-func (cli *Client) GetTeam(ctx context.Context, id string, opts ...Option) (*api.Team, *api.MetaResponse, error) {
+func (cli *Client) GetTeam(ctx context.Context, id string, opts ...Option) (*Team, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetTeam(utils.Atoi(id), apiOptions(ctx)...)
+	team := &Team{}
+	meta, err := cli.newSub().Get(fmt.Sprintf("/teams/%d", utils.Atoi(id)), nil, &team, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return team, meta, nil
 }
 
 func (cli *Client) DeleteTeam(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteTeam(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().DeleteTeam(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) PostTeamMembers(ctx context.Context, id string, params *api.PostTeamsMembersParams, opts ...Option) (int, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostTeamMembers(utils.Atoi(id), params, apiOptions(ctx)...)
+	return cli.newSub().PostTeamMembers(utils.Atoi(id), params, cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) GetTeamMembers(ctx context.Context, id string, opts ...Option) ([]int, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetTeamMembers(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().GetTeamMembers(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
 }
 
 func (cli *Client) DeleteTeamMembers(ctx context.Context, id string, params *api.DeleteTeamMembersParams, opts ...Option) ([]int, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteTeamMembers(utils.Atoi(id), params, apiOptions(ctx)...)
+	return cli.newSub().DeleteTeamMembers(utils.Atoi(id), params, cli.apiOptions(ctx, opts...)...)
 }
 
 // region users
 
-func (cli *Client) GetUsers(ctx context.Context, params *api.GetUsersParams, opts ...Option) ([]*api.User, *api.MetaResponse, error) {
+// User is a CTFd user with its custom fields values, as the underlying
+// client could not decode the boolean ones.
+type User struct {
+	api.User
+
+	Fields []FieldEntry `json:"fields"`
+}
+
+func (cli *Client) GetUsers(ctx context.Context, params *api.GetUsersParams, opts ...Option) ([]*User, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetUsers(params, apiOptions(ctx)...)
+	users := []*User{}
+	meta, err := cli.newSub().Get("/users", params, &users, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return users, meta, nil
 }
 
-func (cli *Client) PostUsers(ctx context.Context, params *api.PostUsersParams, opts ...Option) (*api.User, *api.MetaResponse, error) {
+func (cli *Client) GetUsersMe(ctx context.Context, opts ...Option) (*api.User, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PostUsers(params, apiOptions(ctx)...)
+	user := &User{}
+	meta, err := cli.newSub().Get("/users/me", nil, &user, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return &user.User, meta, nil
 }
 
-func (cli *Client) GetUser(ctx context.Context, id string, opts ...Option) (*api.User, *api.MetaResponse, error) {
+func (cli *Client) PostUsers(ctx context.Context, params *api.PostUsersParams, opts ...Option) (*User, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.GetUser(utils.Atoi(id), apiOptions(ctx)...)
+	user := &User{}
+	meta, err := cli.newSub().Post("/users", params, &user, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return user, meta, nil
 }
 
-func (cli *Client) PatchUser(ctx context.Context, id string, params *api.PatchUsersParams, opts ...Option) (*api.User, *api.MetaResponse, error) {
+func (cli *Client) GetUser(ctx context.Context, id string, opts ...Option) (*User, *api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.PatchUser(utils.Atoi(id), params, apiOptions(ctx)...)
+	user := &User{}
+	meta, err := cli.newSub().Get(fmt.Sprintf("/users/%d", utils.Atoi(id)), nil, &user, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return user, meta, nil
+}
+
+func (cli *Client) PatchUser(ctx context.Context, id string, params *api.PatchUsersParams, opts ...Option) (*User, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	user := &User{}
+	meta, err := cli.newSub().Patch(fmt.Sprintf("/users/%d", utils.Atoi(id)), params, &user, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return user, meta, nil
+}
+
+// PatchUserFields sets the custom fields values of a user. The values of
+// the other fields are kept.
+func (cli *Client) PatchUserFields(ctx context.Context, id string, fields []FieldEntry, opts ...Option) (*User, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	user := &User{}
+	meta, err := cli.newSub().Patch(fmt.Sprintf("/users/%d", utils.Atoi(id)), &patchFieldsParams{
+		Fields: fields,
+	}, &user, cli.apiOptions(ctx, opts...)...)
+	if err != nil {
+		return nil, meta, err
+	}
+	return user, meta, nil
 }
 
 func (cli *Client) DeleteUser(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
 	ctx, span := StartAPISpan(ctx, getTracer(opts...))
 	defer span.End()
 
-	return cli.sub.DeleteUser(utils.Atoi(id), apiOptions(ctx)...)
+	return cli.newSub().DeleteUser(utils.Atoi(id), cli.apiOptions(ctx, opts...)...)
+}
+
+// region tokens
+
+func (cli *Client) PostTokens(ctx context.Context, params *api.PostTokensParams, opts ...Option) (*api.Token, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().PostTokens(params, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) GetToken(ctx context.Context, id string, opts ...Option) (*api.Token, *api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	opts = slices.Concat(opts, []Option{withNotFound()})
+	return cli.newSub().GetToken(id, cli.apiOptions(ctx, opts...)...)
+}
+
+func (cli *Client) DeleteToken(ctx context.Context, id string, opts ...Option) (*api.MetaResponse, error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	return cli.newSub().DeleteToken(id, cli.apiOptions(ctx, opts...)...)
 }
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ctfer-io/go-ctfd/api"
+)
+
+func TestClient_ConcurrentCalls(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"data":{"id":1,"name":"ctfer"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient(srv.URL, "", "", "ctfd_test")
+
+	// Each call goes through its own transport, even when they are concurrent
+	var wg sync.WaitGroup
+	var counts [2]atomic.Int32
+	for i := range 2 {
+		tp := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			counts[i].Add(1)
+			return http.DefaultTransport.RoundTrip(req)
+		})
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				if _, _, err := client.GetUsersMe(ctx, WithTransport(tp)); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	for i := range counts {
+		if got := counts[i].Load(); got != 20 {
+			t.Errorf("transport %d: got %d calls, want 20", i, got)
+		}
+	}
+}
+
+func TestGetNonceAndSession_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	srv, probes := setupServer(t, 0)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, session, err := GetNonceAndSession(context.Background(), srv.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(nonce) != 64 || session != "session" {
+				t.Errorf("got (%q, %q)", nonce, session)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := probes.Load(); got != 20 {
+		t.Errorf("got %d probes, want 20", got)
+	}
+}
+
+func TestGetNonceAndSession_NotFound(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := setupServer(t, 1)
+
+	// The unavailable page has neither a nonce nor a session
+	if _, _, err := GetNonceAndSession(context.Background(), srv.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 1})); err == nil || err.Error() != "nonce not found" {
+		t.Errorf("got error %v, want nonce not found", err)
+	}
+}
+
+func TestClient_Setup_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	setup, _ := setupServer(t, 0)
+	var posts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts.Add(1)
+		}
+		setup.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewAnonymousClient(srv.URL, WithReadOnly())
+
+	// Checking the setup is allowed
+	isSetup, err := client.IsSetup(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isSetup {
+		t.Error("got setup, want not setup")
+	}
+
+	// Completing it is not
+	err = client.Setup(context.Background(), &api.SetupParams{
+		CTFName:  "24h IUT",
+		Name:     "ctfer",
+		Email:    "ctfer-io@protonmail.com",
+		Password: "ctfer",
+	})
+	if !errors.Is(err, ErrReadOnly) {
+		t.Errorf("got error %v, want %v", err, ErrReadOnly)
+	}
+	if got := posts.Load(); got != 0 {
+		t.Errorf("got %d POST requests, want none", got)
+	}
+}
+
+func TestClient_GetToken_NotFound(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"The requested URL was not found on the server."}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient(srv.URL, "", "", "ctfd_test")
+
+	if _, _, err := client.GetToken(context.Background(), "1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v, want %v", err, ErrNotFound)
+	}
+	// Other calls are left unchanged
+	if _, _, err := client.GetUser(context.Background(), "1"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v, want a CTFd error", err)
+	}
+}
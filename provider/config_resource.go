@@ -0,0 +1,442 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*configResource)(nil)
+	_ resource.ResourceWithConfigure   = (*configResource)(nil)
+	_ resource.ResourceWithImportState = (*configResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*configResource)(nil)
+)
+
+// configPreviousKey is the private state key of the config values before
+// the resource managed them.
+const configPreviousKey = "previous"
+
+func NewConfigResource() resource.Resource {
+	return &configResource{}
+}
+
+type configResource struct {
+	fm *Framework
+}
+
+type configResourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Values   map[string]types.String `tfsdk:"values"`
+	Timeouts timeouts.Value          `tfsdk:"timeouts"`
+}
+
+func (r *configResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+func (r *configResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Instance-wide CTFd settings (e.g. `ctf_name`, `user_mode`, `team_size`, `score_visibility`, `paused`...), as stored by its configs API.\n\nThe resource is authoritative only over the keys it lists, such that others could be managed elsewhere. On destroy, or when a key is no longer listed, its value before the resource managed it is restored.\n\nIt could be imported with its keys, comma-separated (e.g. `ctf_name,user_mode`). As their previous values are then unknown, imported keys are left as is on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the config, i.e. its keys sorted and comma-separated.",
+				Computed:            true,
+			},
+			"values": schema.MapAttribute{
+				MarkdownDescription: "Config values by key (e.g. `ctf_name = \"My CTF\"`). CTFd stores all of them as strings, so booleans are expected as `\"true\"` or `\"false\"`, and numbers as their string representation.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *configResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *configResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_config")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var values types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("values"), &values)...)
+	if resp.Diagnostics.HasError() || values.IsNull() || values.IsUnknown() {
+		return
+	}
+	if len(values.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("values"),
+			"Invalid config",
+			"At least one config key must be set.",
+		)
+		return
+	}
+	if _, ok := values.Elements()[""]; ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("values"),
+			"Invalid config",
+			"Config keys must not be empty.",
+		)
+		return
+	}
+
+	// The identifier only depends on the keys, so is known ahead
+	id := strings.Join(slices.Sorted(maps.Keys(values.Elements())), ",")
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *configResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Save the previous values to restore them on destroy
+	current, err := r.getConfigs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get configs, got error: %s", err),
+		)
+		return
+	}
+	previous := map[string]*string{}
+	for k := range data.Values {
+		previous[k] = current[k]
+	}
+
+	// Set config values
+	if _, err := r.fm.Client.PatchConfigs(ctx, configValues(data.Values), WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to set configs, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "set configs")
+
+	resp.Diagnostics.Append(setConfigPrevious(ctx, resp.Private, previous)...)
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(configID(data.Values))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data configResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	current, err := r.getConfigs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get configs, got error: %s", err),
+		)
+		return
+	}
+
+	// Upsert values, dropping the keys removed out of Terraform
+	for k := range data.Values {
+		v, ok := current[k]
+		if !ok || v == nil {
+			delete(data.Values, k)
+			continue
+		}
+		data.Values[k] = types.StringValue(*v)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data, dataState configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &dataState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	previous, diags := getConfigPrevious(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the previous values of the new keys
+	current, err := r.getConfigs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get configs, got error: %s", err),
+		)
+		return
+	}
+	for k := range data.Values {
+		if _, ok := dataState.Values[k]; !ok {
+			previous[k] = current[k]
+		}
+	}
+
+	// Restore the keys no longer managed
+	removed := map[string]*string{}
+	for k := range dataState.Values {
+		if _, ok := data.Values[k]; ok {
+			continue
+		}
+		if v, ok := previous[k]; ok {
+			removed[k] = v
+		}
+		delete(previous, k)
+	}
+	if err := r.restore(ctx, removed); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to restore configs, got error: %s", err),
+		)
+		return
+	}
+
+	// Set config values
+	if _, err := r.fm.Client.PatchConfigs(ctx, configValues(data.Values), WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to set configs, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setConfigPrevious(ctx, resp.Private, previous)...)
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(configID(data.Values))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data configResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	previous, diags := getConfigPrevious(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported keys have no previous value, so are left as is
+	restored := map[string]*string{}
+	for k := range data.Values {
+		if v, ok := previous[k]; ok {
+			restored[k] = v
+		}
+	}
+	if err := r.restore(ctx, restored); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore configs, got error: %s", err))
+		return
+	}
+}
+
+func (r *configResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	values := map[string]types.String{}
+	for _, k := range strings.Split(req.ID, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			values[k] = types.StringNull()
+		}
+	}
+	if len(values) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected comma-separated config keys (e.g. \"ctf_name,user_mode\"), got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), configID(values))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("values"), values)...)
+
+	// Automatically call r.Read
+}
+
+// getConfigs returns all the config values by key, nil if not set.
+func (r *configResource) getConfigs(ctx context.Context) (map[string]*string, error) {
+	configs, _, err := r.fm.Client.GetConfigs(ctx, &api.GetConfigsParams{}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]*string, len(configs))
+	for _, cfg := range configs {
+		current[cfg.Key] = &cfg.Value
+	}
+	return current, nil
+}
+
+// restore sets back the previous values, deleting the keys that were
+// not set.
+func (r *configResource) restore(ctx context.Context, previous map[string]*string) error {
+	values := map[string]string{}
+	for k, v := range previous {
+		if v == nil {
+			if _, err := r.fm.Client.DeleteConfigsByKey(ctx, k, WithTracerProvider(r.fm.Tp)); err != nil {
+				return err
+			}
+			continue
+		}
+		values[k] = *v
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	_, err := r.fm.Client.PatchConfigs(ctx, values, WithTracerProvider(r.fm.Tp))
+	return err
+}
+
+func configValues(values map[string]types.String) map[string]string {
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		out[k] = v.ValueString()
+	}
+	return out
+}
+
+func configID(values map[string]types.String) string {
+	return strings.Join(slices.Sorted(maps.Keys(values)), ",")
+}
+
+// privateStateGetter and privateStateSetter are implemented by the
+// resources private state, which type is internal to the framework.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func getConfigPrevious(ctx context.Context, private privateStateGetter) (map[string]*string, diag.Diagnostics) {
+	previous := map[string]*string{}
+	b, diags := private.GetKey(ctx, configPreviousKey)
+	if diags.HasError() || len(b) == 0 {
+		return previous, diags
+	}
+	if err := json.Unmarshal(b, &previous); err != nil {
+		diags.AddError(
+			"Invalid private state",
+			fmt.Sprintf("Unable to decode the previous config values, got error: %s", err),
+		)
+	}
+	return previous, diags
+}
+
+func setConfigPrevious(ctx context.Context, private privateStateSetter, previous map[string]*string) diag.Diagnostics {
+	b, err := json.Marshal(previous)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Invalid private state",
+			fmt.Sprintf("Unable to encode the previous config values, got error: %s", err),
+		)
+		return diags
+	}
+	return private.SetKey(ctx, configPreviousKey, b)
+}
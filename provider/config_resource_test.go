@@ -0,0 +1,105 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Config_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_config" "event" {
+	values = {
+		ctf_name        = "My CTF"
+		ctf_description = "A Capture The Flag event, as code."
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_config.event", "id", "ctf_description,ctf_name"),
+					resource.TestCheckResourceAttr("ctfd_config.event", "values.ctf_name", "My CTF"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "ctfd_config.event",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_config" "event" {
+	values = {
+		ctf_name  = "My CTF 2026"
+		team_size = "4"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_config.event", "id", "ctf_name,team_size"),
+					resource.TestCheckResourceAttr("ctfd_config.event", "values.ctf_name", "My CTF 2026"),
+					resource.TestCheckResourceAttr("ctfd_config.event", "values.team_size", "4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAcc_Config_Timeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Exceeded timeout
+			{
+				Config: providerConfig + `
+resource "ctfd_config" "event" {
+	values = {
+		ctf_name = "My CTF"
+	}
+
+	timeouts {
+		create = "1ns"
+	}
+}
+`,
+				ExpectError: regexp.MustCompile(`deadline\s+exceeded`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_config" "event" {
+	values = {
+		ctf_name = "My CTF"
+	}
+
+	timeouts {
+		create = "5m"
+		read   = "1m"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_config.event", "values.ctf_name", "My CTF"),
+					resource.TestCheckResourceAttr("ctfd_config.event", "timeouts.create", "5m"),
+					resource.TestCheckResourceAttr("ctfd_config.event", "timeouts.read", "1m"),
+				),
+			},
+			// ImportState testing, the timeouts are not part of CTFd
+			{
+				ResourceName:            "ctfd_config.event",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode"
+)
+
+// Credentials are the values a credential process could supply.
+type Credentials struct {
+	URL      string `json:"url"`
+	APIKey   string `json:"api_key"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// RunCredentialProcess runs the command and decodes the credentials it
+// writes on its standard output, as JSON.
+// The command is not run through a shell, but arguments could be quoted.
+func RunCredentialProcess(ctx context.Context, command string) (*Credentials, error) {
+	args, err := splitCommand(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return nil, errors.New("empty command")
+	}
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+
+	creds := &Credentials{}
+	if err := json.Unmarshal(stdout.Bytes(), creds); err != nil {
+		// Don't wrap the error, as it could contain part of the output
+		return nil, errors.New("invalid JSON output, expected an object with url, api_key, username and password")
+	}
+	return creds, nil
+}
+
+// splitCommand splits a command line in arguments, on spaces out of
+// single or double quotes.
+func splitCommand(command string) ([]string, error) {
+	args := []string{}
+	var arg strings.Builder
+	var quote rune
+	inArg := false
+	for _, r := range command {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote %c", quote)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// readSecretFile reads a secret from a file, without the trailing new line
+// most editors and secret managers add.
+func readSecretFile(name string) (string, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
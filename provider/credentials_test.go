@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSplitCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		command string
+		want    []string
+		wantErr bool
+	}{
+		"empty": {
+			command: "",
+			want:    []string{},
+		},
+		"spaces": {
+			command: "  vault   kv get  ",
+			want:    []string{"vault", "kv", "get"},
+		},
+		"double-quotes": {
+			command: `sh -c "echo 'ctfer'"`,
+			want:    []string{"sh", "-c", "echo 'ctfer'"},
+		},
+		"single-quotes": {
+			command: `sh -c 'echo "ctfer"'`,
+			want:    []string{"sh", "-c", `echo "ctfer"`},
+		},
+		"quoted-part": {
+			command: `--field="a b"c`,
+			want:    []string{"--field=a bc"},
+		},
+		"empty-quotes": {
+			command: `cmd "" ''`,
+			want:    []string{"cmd", "", ""},
+		},
+		"unterminated": {
+			command: `sh -c "echo`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := splitCommand(tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCredentialProcess(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		command string
+		want    *Credentials
+		wantErr string
+	}{
+		"valid": {
+			command: `sh -c 'echo "{\"url\":\"http://ctfd\",\"username\":\"ctfer\",\"password\":\"ctfer\"}"'`,
+			want: &Credentials{
+				URL:      "http://ctfd",
+				Username: "ctfer",
+				Password: "ctfer",
+			},
+		},
+		"empty-command": {
+			command: "  ",
+			wantErr: "empty command",
+		},
+		"empty-output": {
+			command: "true",
+			wantErr: "invalid JSON output",
+		},
+		"invalid-output": {
+			command: `sh -c 'echo s3cr3t'`,
+			wantErr: "invalid JSON output",
+		},
+		"non-zero-exit": {
+			command: "false",
+			wantErr: "exit status 1",
+		},
+		"non-zero-exit-stderr": {
+			command: `sh -c 'echo "vault is sealed" >&2; exit 2'`,
+			wantErr: "exit status 2: vault is sealed",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := RunCredentialProcess(context.Background(), tt.command)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				// The output could be a secret
+				if strings.Contains(err.Error(), "s3cr3t") {
+					t.Errorf("error leaks the output: %s", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadSecretFile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content string
+		want    string
+	}{
+		"no-newline": {
+			content: "s3cr3t",
+			want:    "s3cr3t",
+		},
+		"newline": {
+			content: "s3cr3t\n",
+			want:    "s3cr3t",
+		},
+		"crlf": {
+			content: "s3cr3t\r\n",
+			want:    "s3cr3t",
+		},
+		"newlines": {
+			content: "s3cr3t\n\n",
+			want:    "s3cr3t",
+		},
+		"spaces": {
+			content: " s3cr3t \n",
+			want:    " s3cr3t ",
+		},
+		"multiline": {
+			content: "s3\ncr3t\n",
+			want:    "s3\ncr3t",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			name := filepath.Join(t.TempDir(), "secret")
+			if err := os.WriteFile(name, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			got, err := readSecretFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := readSecretFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestConfigure_SecretFiles(t *testing.T) {
+	missing := tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing"))
+
+	// The configuration is then rejected, before connecting to CTFd
+	values := func(attrs map[string]tftypes.Value) map[string]tftypes.Value {
+		attrs["url"] = tftypes.NewValue(tftypes.String, "https://ctfd.ctfer.io")
+		attrs["ca_cert_pem"] = tftypes.NewValue(tftypes.String, "not a PEM")
+		return attrs
+	}
+
+	tests := map[string]struct {
+		values map[string]tftypes.Value
+		// want is the attribute of the file error, if the file is read
+		want string
+	}{
+		"api-key-file": {
+			values: values(map[string]tftypes.Value{
+				"api_key_file": missing,
+			}),
+			want: "api_key_file",
+		},
+		"api-key-overrides": {
+			values: values(map[string]tftypes.Value{
+				"api_key":      tftypes.NewValue(tftypes.String, "ctfd_test"),
+				"api_key_file": missing,
+			}),
+		},
+		"password-file": {
+			values: values(map[string]tftypes.Value{
+				"username":      tftypes.NewValue(tftypes.String, "ctfer"),
+				"password_file": missing,
+			}),
+			want: "password_file",
+		},
+		"password-overrides": {
+			values: values(map[string]tftypes.Value{
+				"username":      tftypes.NewValue(tftypes.String, "ctfer"),
+				"password":      tftypes.NewValue(tftypes.String, "ctfer"),
+				"password_file": missing,
+			}),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := testConfigure(t, tt.values, false)
+			if got := resp.Diagnostics.ErrorsCount(); got != 1 {
+				t.Fatalf("got %d errors, want 1: %v", got, resp.Diagnostics.Errors())
+			}
+			d := resp.Diagnostics.Errors()[0]
+			if tt.want == "" {
+				if !strings.Contains(d.Detail(), "Invalid TLS configuration") {
+					t.Errorf("unexpected error: %s", d.Detail())
+				}
+				return
+			}
+			if wd, ok := d.(diag.DiagnosticWithPath); !ok || !wd.Path().Equal(path.Root(tt.want)) {
+				t.Errorf("got %v, want an error on %s", d, tt.want)
+			}
+		})
+	}
+}
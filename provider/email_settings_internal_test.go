@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConfigBool(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		current map[string]string
+		want    types.Bool
+	}{
+		"true": {
+			current: map[string]string{"mail_tls": "true"},
+			want:    types.BoolValue(true),
+		},
+		"false": {
+			current: map[string]string{"mail_tls": "false"},
+			want:    types.BoolValue(false),
+		},
+		"unset": {
+			current: map[string]string{},
+			want:    types.BoolNull(),
+		},
+		"not-a-boolean": {
+			current: map[string]string{"mail_tls": "maybe"},
+			want:    types.BoolNull(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b := configBool(tt.current, "mail_tls")
+			if !b.Equal(tt.want) {
+				t.Errorf("got %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		current map[string]string
+		want    *emailTemplateSubresourceModel
+	}{
+		"set": {
+			current: map[string]string{
+				"verification_email_subject": "Confirm",
+				"verification_email_body":    "Go to {url}",
+			},
+			want: &emailTemplateSubresourceModel{
+				Subject: types.StringValue("Confirm"),
+				Body:    types.StringValue("Go to {url}"),
+			},
+		},
+		"unset": {
+			current: map[string]string{},
+			want:    nil,
+		},
+		"subject-only": {
+			current: map[string]string{"verification_email_subject": "Confirm"},
+			want:    nil,
+		},
+		"body-only": {
+			current: map[string]string{"verification_email_body": "Go to {url}"},
+			want:    nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tpl := configTemplate(tt.current, "verification_email_subject", "verification_email_body")
+			if !reflect.DeepEqual(tpl, tt.want) {
+				t.Errorf("got %v, want %v", tpl, tt.want)
+			}
+		})
+	}
+}
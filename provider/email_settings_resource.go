@@ -0,0 +1,591 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*emailSettingsResource)(nil)
+	_ resource.ResourceWithConfigure   = (*emailSettingsResource)(nil)
+	_ resource.ResourceWithImportState = (*emailSettingsResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*emailSettingsResource)(nil)
+)
+
+// emailSettingsID is the identifier of the email settings, as there is
+// only one per CTFd instance.
+const emailSettingsID = "email"
+
+func NewEmailSettingsResource() resource.Resource {
+	return &emailSettingsResource{}
+}
+
+type emailSettingsResource struct {
+	fm *Framework
+}
+
+type emailSettingsResourceModel struct {
+	ID                       types.String                   `tfsdk:"id"`
+	FromAddress              types.String                   `tfsdk:"from_address"`
+	SMTPHost                 types.String                   `tfsdk:"smtp_host"`
+	SMTPPort                 types.Int64                    `tfsdk:"smtp_port"`
+	SMTPUsername             types.String                   `tfsdk:"smtp_username"`
+	SMTPAuth                 types.Bool                     `tfsdk:"smtp_auth"`
+	SMTPPasswordWO           types.String                   `tfsdk:"smtp_password_wo"`
+	SMTPPasswordWOVersion    types.Int64                    `tfsdk:"smtp_password_wo_version"`
+	SMTPTLS                  types.Bool                     `tfsdk:"smtp_tls"`
+	SMTPSSL                  types.Bool                     `tfsdk:"smtp_ssl"`
+	MailgunBaseURL           types.String                   `tfsdk:"mailgun_base_url"`
+	MailgunAPIKeyWO          types.String                   `tfsdk:"mailgun_api_key_wo"`
+	MailgunAPIKeyWOVersion   types.Int64                    `tfsdk:"mailgun_api_key_wo_version"`
+	VerifyEmails             types.Bool                     `tfsdk:"verify_emails"`
+	RegistrationEmail        *emailTemplateSubresourceModel `tfsdk:"registration_email"`
+	VerificationEmail        *emailTemplateSubresourceModel `tfsdk:"verification_email"`
+	UserCreationEmail        *emailTemplateSubresourceModel `tfsdk:"user_creation_email"`
+	PasswordResetEmail       *emailTemplateSubresourceModel `tfsdk:"password_reset_email"`
+	PasswordChangeAlertEmail *emailTemplateSubresourceModel `tfsdk:"password_change_alert_email"`
+	Timeouts                 timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+type emailTemplateSubresourceModel struct {
+	Subject types.String `tfsdk:"subject"`
+	Body    types.String `tfsdk:"body"`
+}
+
+// emailSecret binds a write-only attribute of the email settings to its
+// config key, and to the version that triggers its update.
+type emailSecret struct {
+	attr    string
+	key     string
+	version *types.Int64
+}
+
+func (data *emailSettingsResourceModel) secrets() []emailSecret {
+	return []emailSecret{
+		{attr: "smtp_password_wo", key: "mail_password", version: &data.SMTPPasswordWOVersion},
+		{attr: "mailgun_api_key_wo", key: "mailgun_api_key", version: &data.MailgunAPIKeyWOVersion},
+	}
+}
+
+// emailTemplate binds an email template to its config keys.
+type emailTemplate struct {
+	subjectKey string
+	bodyKey    string
+	value      **emailTemplateSubresourceModel
+}
+
+func (data *emailSettingsResourceModel) templates() []emailTemplate {
+	return []emailTemplate{
+		{subjectKey: "successful_registration_email_subject", bodyKey: "successful_registration_email_body", value: &data.RegistrationEmail},
+		{subjectKey: "verification_email_subject", bodyKey: "verification_email_body", value: &data.VerificationEmail},
+		{subjectKey: "user_creation_email_subject", bodyKey: "user_creation_email_body", value: &data.UserCreationEmail},
+		{subjectKey: "password_reset_subject", bodyKey: "password_reset_body", value: &data.PasswordResetEmail},
+		{subjectKey: "password_change_alert_subject", bodyKey: "password_change_alert_body", value: &data.PasswordChangeAlertEmail},
+	}
+}
+
+// resolveSMTPAuth defaults smtp_auth if it was unknown at plan time,
+// i.e. as smtp_username was.
+func (data *emailSettingsResourceModel) resolveSMTPAuth() {
+	if data.SMTPAuth.IsUnknown() {
+		data.SMTPAuth = types.BoolValue(!data.SMTPUsername.IsNull())
+	}
+}
+
+// values returns the config values of the email settings, nil if unset.
+// The secrets are not part of them.
+func (data *emailSettingsResourceModel) values() map[string]*string {
+	values := map[string]*string{
+		"mailfrom_addr":    data.FromAddress.ValueStringPointer(),
+		"mail_server":      data.SMTPHost.ValueStringPointer(),
+		"mail_port":        nil,
+		"mail_username":    data.SMTPUsername.ValueStringPointer(),
+		"mail_useauth":     boolConfig(data.SMTPAuth),
+		"mail_tls":         boolConfig(data.SMTPTLS),
+		"mail_ssl":         boolConfig(data.SMTPSSL),
+		"mailgun_base_url": data.MailgunBaseURL.ValueStringPointer(),
+		"verify_emails":    boolConfig(data.VerifyEmails),
+	}
+	if !data.SMTPPort.IsNull() {
+		port := strconv.FormatInt(data.SMTPPort.ValueInt64(), 10)
+		values["mail_port"] = &port
+	}
+	for _, tpl := range data.templates() {
+		values[tpl.subjectKey] = nil
+		values[tpl.bodyKey] = nil
+		if t := *tpl.value; t != nil {
+			values[tpl.subjectKey] = t.Subject.ValueStringPointer()
+			values[tpl.bodyKey] = t.Body.ValueStringPointer()
+		}
+	}
+	return values
+}
+
+func (r *emailSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_settings"
+}
+
+func (r *emailSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The email settings of the CTFd instance, i.e. the SMTP server or Mailgun account it sends emails through, and their templates. They are required for the account verification and the password reset.\n\nThe resource is authoritative over all of them, such that an unset attribute is removed from CTFd, and a change through the web UI is reported as a drift. There is only one per CTFd instance.\n\nThe secrets are write-only, thus never stored in the state nor displayed in the plan (requires Terraform 1.11 or later). As CTFd could not be compared against them, they are only sent on creation, and once their `_wo_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the email settings, always `" + emailSettingsID + "`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"from_address": schema.StringAttribute{
+				MarkdownDescription: "Email address the emails are sent from (e.g. `noreply@ctfer.io`).",
+				Optional:            true,
+			},
+			"smtp_host": schema.StringAttribute{
+				MarkdownDescription: "Host of the SMTP server to send the emails through.",
+				Optional:            true,
+			},
+			"smtp_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the SMTP server (e.g. `587`).",
+				Optional:            true,
+			},
+			"smtp_username": schema.StringAttribute{
+				MarkdownDescription: "Username to authenticate to the SMTP server with, if `smtp_auth` is true.",
+				Optional:            true,
+			},
+			"smtp_auth": schema.BoolAttribute{
+				MarkdownDescription: "Is true if CTFd authenticates to the SMTP server, with `smtp_username` and `smtp_password_wo`. Default to `true` if `smtp_username` is set, else `false`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smtp_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password to authenticate to the SMTP server with. Requires `smtp_password_wo_version`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"smtp_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the `smtp_password_wo`, to increment once it changed such that it is sent again to CTFd.",
+				Optional:            true,
+			},
+			"smtp_tls": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the connection to the SMTP server is upgraded with STARTTLS. Default to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"smtp_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the connection to the SMTP server uses SSL/TLS from the start. Default to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mailgun_base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Mailgun API of the domain (e.g. `https://api.mailgun.net/v3/ctfer.io`), as an alternative to an SMTP server.",
+				Optional:            true,
+			},
+			"mailgun_api_key_wo": schema.StringAttribute{
+				MarkdownDescription: "API key of the Mailgun account. Requires `mailgun_api_key_wo_version`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"mailgun_api_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the `mailgun_api_key_wo`, to increment once it changed such that it is sent again to CTFd.",
+				Optional:            true,
+			},
+			"verify_emails": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the users must verify their email address before playing. Default to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"registration_email":          emailTemplateAttribute("Email sent once a user registered."),
+			"verification_email":          emailTemplateAttribute("Email sent to verify the address of a user, if `verify_emails` is true."),
+			"user_creation_email":         emailTemplateAttribute("Email sent once an administrator created a user, e.g. through the `ctfd_user` resource."),
+			"password_reset_email":        emailTemplateAttribute("Email sent once a user requested a password reset."),
+			"password_change_alert_email": emailTemplateAttribute("Email sent once the password of a user changed."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func emailTemplateAttribute(desc string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: desc + " If not set, CTFd uses its default template.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the email.",
+				Required:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "Body of the email, in which CTFd substitutes the variables within braces (e.g. `{ctf_name}`, `{url}`).",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *emailSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *emailSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_email_settings")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tls, ssl types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("smtp_tls"), &tls)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("smtp_ssl"), &ssl)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tls.ValueBool() && ssl.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("smtp_ssl"),
+			"Invalid email settings",
+			"smtp_tls and smtp_ssl are mutually exclusive.",
+		)
+	}
+
+	// Authenticate to the SMTP server once a username is provided, unless
+	// configured otherwise. An unknown username is resolved on apply.
+	var auth types.Bool
+	var username types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("smtp_auth"), &auth)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("smtp_username"), &username)...)
+	resp.Diagnostics.Append(diags...)
+	if !diags.HasError() && auth.IsNull() && !username.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("smtp_auth"), !username.IsNull())...)
+	}
+
+	// A secret without version would never be sent, and conversely
+	for _, attr := range []string{"smtp_password_wo", "mailgun_api_key_wo"} {
+		var secret types.String
+		var version types.Int64
+		diags := req.Config.GetAttribute(ctx, path.Root(attr), &secret)
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(attr+"_version"), &version)...)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		if secret.IsUnknown() || version.IsUnknown() {
+			continue
+		}
+		if secret.IsNull() != version.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr+"_version"),
+				"Invalid email settings",
+				fmt.Sprintf("%s and %s_version must be set together.", attr, attr),
+			)
+		}
+	}
+}
+
+func (r *emailSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data emailSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	data.resolveSMTPAuth()
+	values := data.values()
+	for _, s := range data.secrets() {
+		var secret types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(s.attr), &secret)...)
+		values[s.key] = secret.ValueStringPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, values); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to set email settings, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "set the email settings")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(emailSettingsID)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data emailSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	configs, _, err := r.fm.Client.GetConfigs(ctx, &api.GetConfigsParams{}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get email settings, got error: %s", err),
+		)
+		return
+	}
+	// CTFd stores the emptied settings of its web UI as empty strings
+	current := map[string]string{}
+	for _, cfg := range configs {
+		if cfg.Value != "" {
+			current[cfg.Key] = cfg.Value
+		}
+	}
+
+	data.FromAddress = configString(current, "mailfrom_addr")
+	data.SMTPHost = configString(current, "mail_server")
+	data.SMTPUsername = configString(current, "mail_username")
+	data.MailgunBaseURL = configString(current, "mailgun_base_url")
+	data.SMTPPort = types.Int64Null()
+	if v, ok := current["mail_port"]; ok {
+		port, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Invalid mail_port %q, got error: %s", v, err),
+			)
+			return
+		}
+		data.SMTPPort = types.Int64Value(port)
+	}
+	data.SMTPAuth = configBool(current, "mail_useauth")
+	data.SMTPTLS = configBool(current, "mail_tls")
+	data.SMTPSSL = configBool(current, "mail_ssl")
+	data.VerifyEmails = configBool(current, "verify_emails")
+	for _, tpl := range data.templates() {
+		*tpl.value = configTemplate(current, tpl.subjectKey, tpl.bodyKey)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data, dataState emailSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &dataState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only send the secrets which version changed, as the current ones
+	// could not be compared against
+	data.resolveSMTPAuth()
+	values := data.values()
+	secretsState := dataState.secrets()
+	for i, s := range data.secrets() {
+		if s.version.IsNull() {
+			values[s.key] = nil
+			continue
+		}
+		if s.version.Equal(*secretsState[i].version) {
+			continue
+		}
+		var secret types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(s.attr), &secret)...)
+		values[s.key] = secret.ValueStringPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, values); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update email settings, got error: %s", err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data emailSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Unset all settings, secrets included
+	values := data.values()
+	for k := range values {
+		values[k] = nil
+	}
+	for _, s := range data.secrets() {
+		values[s.key] = nil
+	}
+	if err := r.apply(ctx, values); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete email settings, got error: %s", err))
+		return
+	}
+}
+
+func (r *emailSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), emailSettingsID)...)
+
+	// Automatically call r.Read
+}
+
+// apply sets the config values, and removes the unset ones.
+func (r *emailSettingsResource) apply(ctx context.Context, values map[string]*string) error {
+	configs, _, err := r.fm.Client.GetConfigs(ctx, &api.GetConfigsParams{}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		return err
+	}
+	current := map[string]struct{}{}
+	for _, cfg := range configs {
+		current[cfg.Key] = struct{}{}
+	}
+
+	set := map[string]string{}
+	for k, v := range values {
+		if v == nil {
+			if _, ok := current[k]; ok {
+				if _, err := r.fm.Client.DeleteConfigsByKey(ctx, k, WithTracerProvider(r.fm.Tp)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		set[k] = *v
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	_, err = r.fm.Client.PatchConfigs(ctx, set, WithTracerProvider(r.fm.Tp))
+	return err
+}
+
+func configString(current map[string]string, key string) types.String {
+	if v, ok := current[key]; ok {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
+
+// configBool returns the boolean config value, null if unset or not
+// a boolean. As booleans are always set, a null value is a drift.
+func configBool(current map[string]string, key string) types.Bool {
+	b, err := strconv.ParseBool(current[key])
+	if err != nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(b)
+}
+
+// configTemplate returns the email template config value, nil unless both
+// its subject and body are set, as CTFd uses its default template otherwise.
+func configTemplate(current map[string]string, subjectKey, bodyKey string) *emailTemplateSubresourceModel {
+	subject, okSubject := current[subjectKey]
+	body, okBody := current[bodyKey]
+	if !okSubject || !okBody {
+		return nil
+	}
+	return &emailTemplateSubresourceModel{
+		Subject: types.StringValue(subject),
+		Body:    types.StringValue(body),
+	}
+}
+
+// boolConfig returns the config value of a boolean, as CTFd stores all of
+// them as strings.
+func boolConfig(v types.Bool) *string {
+	s := strconv.FormatBool(v.ValueBool())
+	return &s
+}
@@ -0,0 +1,113 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EmailSettings_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Write-only attributes are supported since Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_email_settings" "smtp" {
+	from_address = "noreply@ctfer.io"
+
+	smtp_host                = "smtp.ctfer.io"
+	smtp_port                = 587
+	smtp_tls                 = true
+	smtp_username            = "ctfd"
+	smtp_password_wo         = "password"
+	smtp_password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "id", "email"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_auth", "true"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_tls", "true"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_ssl", "false"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "verify_emails", "false"),
+					resource.TestCheckNoResourceAttr("ctfd_email_settings.smtp", "smtp_password_wo"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "ctfd_email_settings.smtp",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"smtp_password_wo_version"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_email_settings" "smtp" {
+	from_address = "noreply@ctfer.io"
+
+	smtp_host                = "smtp.ctfer.io"
+	smtp_port                = 465
+	smtp_ssl                 = true
+	smtp_username            = "ctfd"
+	smtp_password_wo         = "new-password"
+	smtp_password_wo_version = 2
+
+	verify_emails = true
+
+	verification_email = {
+		subject = "Confirm your account for {ctf_name}"
+		body    = "Click the following link to confirm your email address: {url}"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_port", "465"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_tls", "false"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_auth", "true"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "verify_emails", "true"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "verification_email.subject", "Confirm your account for {ctf_name}"),
+				),
+			},
+			// Update without authentication
+			{
+				Config: providerConfig + `
+resource "ctfd_email_settings" "smtp" {
+	from_address = "noreply@ctfer.io"
+
+	smtp_host = "smtp.ctfer.io"
+	smtp_port = 25
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_auth", "false"),
+					resource.TestCheckNoResourceAttr("ctfd_email_settings.smtp", "smtp_username"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "verify_emails", "false"),
+				),
+			},
+			// Authentication disabled while a username is set
+			{
+				Config: providerConfig + `
+resource "ctfd_email_settings" "smtp" {
+	from_address = "noreply@ctfer.io"
+
+	smtp_host     = "smtp.ctfer.io"
+	smtp_port     = 25
+	smtp_username = "ctfd"
+	smtp_auth     = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_auth", "false"),
+					resource.TestCheckResourceAttr("ctfd_email_settings.smtp", "smtp_username", "ctfd"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,421 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*eventScheduleResource)(nil)
+	_ resource.ResourceWithConfigure   = (*eventScheduleResource)(nil)
+	_ resource.ResourceWithImportState = (*eventScheduleResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*eventScheduleResource)(nil)
+)
+
+// eventScheduleID is the identifier of the event schedule, as there is
+// only one per CTFd instance.
+const eventScheduleID = "schedule"
+
+func NewEventScheduleResource() resource.Resource {
+	return &eventScheduleResource{}
+}
+
+type eventScheduleResource struct {
+	fm *Framework
+}
+
+type eventScheduleResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Start    types.String   `tfsdk:"start"`
+	End      types.String   `tfsdk:"end"`
+	Freeze   types.String   `tfsdk:"freeze"`
+	Timezone types.String   `tfsdk:"timezone"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// eventScheduleKey binds an attribute of the event schedule to its config key.
+type eventScheduleKey struct {
+	key   string
+	value *types.String
+}
+
+func (data *eventScheduleResourceModel) keys() []eventScheduleKey {
+	return []eventScheduleKey{
+		{key: "start", value: &data.Start},
+		{key: "end", value: &data.End},
+		{key: "freeze", value: &data.Freeze},
+	}
+}
+
+// location returns the timezone of the date-times, default to UTC.
+func (data *eventScheduleResourceModel) location() (*time.Location, error) {
+	if data.Timezone.IsNull() || data.Timezone.IsUnknown() {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(data.Timezone.ValueString())
+}
+
+func (r *eventScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_schedule"
+}
+
+func (r *eventScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The time window of the Capture The Flag event, i.e. when it starts, ends, and when the scoreboard freezes.\n\nThe resource is authoritative over the three of them, such that an unset attribute is removed from CTFd, and a date changed through the web UI is reported as a drift. There is only one per CTFd instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the event schedule, always `" + eventScheduleID + "`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "When the event starts, as an RFC3339 date-time (e.g. `2026-10-17T09:00:00+02:00`), or without offset to use the `timezone`. If not set, the event is started.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewDateTimeValidator(),
+				},
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "When the event ends, as an RFC3339 date-time, or without offset to use the `timezone`. Must be after `start`. If not set, the event never ends.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewDateTimeValidator(),
+				},
+			},
+			"freeze": schema.StringAttribute{
+				MarkdownDescription: "When the scoreboard freezes, as an RFC3339 date-time, or without offset to use the `timezone`. Must be after `start`, and not after `end`. If not set, the scoreboard never freezes.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewDateTimeValidator(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "IANA timezone (e.g. `Europe/Paris`) of the date-times without offset. Dates changed out of Terraform are also reported in this timezone. Default to UTC.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewTimezoneValidator(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *eventScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *eventScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_event_schedule")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data eventScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Timezone.IsUnknown() {
+		return
+	}
+	loc, err := data.location()
+	if err != nil {
+		// Already reported by the validator
+		return
+	}
+
+	// Check the order of the known dates
+	parse := func(v types.String) *time.Time {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		t, err := validators.ParseDateTime(v.ValueString(), loc)
+		if err != nil {
+			return nil
+		}
+		return &t
+	}
+	start, end, freeze := parse(data.Start), parse(data.End), parse(data.Freeze)
+	if start != nil && end != nil && !start.Before(*end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Invalid event schedule",
+			fmt.Sprintf("The event must end after it starts, got start %s and end %s.", data.Start.ValueString(), data.End.ValueString()),
+		)
+	}
+	if start != nil && freeze != nil && !start.Before(*freeze) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("freeze"),
+			"Invalid event schedule",
+			fmt.Sprintf("The scoreboard must freeze after the event starts, got start %s and freeze %s.", data.Start.ValueString(), data.Freeze.ValueString()),
+		)
+	}
+	if freeze != nil && end != nil && freeze.After(*end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("freeze"),
+			"Invalid event schedule",
+			fmt.Sprintf("The scoreboard must freeze before the event ends, got freeze %s and end %s.", data.Freeze.ValueString(), data.End.ValueString()),
+		)
+	}
+}
+
+func (r *eventScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data eventScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to set event schedule, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "set the event schedule")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(eventScheduleID)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *eventScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data eventScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	loc, err := data.location()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timezone"),
+			"Invalid timezone",
+			err.Error(),
+		)
+		return
+	}
+
+	current, err := r.getSchedule(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get event schedule, got error: %s", err),
+		)
+		return
+	}
+
+	// Upsert values, keeping the current representation of the dates
+	// if they did not change
+	for _, k := range data.keys() {
+		t, ok := current[k.key]
+		if !ok {
+			*k.value = types.StringNull()
+			continue
+		}
+		if !k.value.IsNull() {
+			if prev, err := validators.ParseDateTime(k.value.ValueString(), loc); err == nil && prev.Equal(t) {
+				continue
+			}
+		}
+		*k.value = types.StringValue(t.In(loc).Format(time.RFC3339))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *eventScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data eventScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update event schedule, got error: %s", err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *eventScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data eventScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Unset all dates, i.e. the event is always running
+	if err := r.apply(ctx, &eventScheduleResourceModel{
+		Start:  types.StringNull(),
+		End:    types.StringNull(),
+		Freeze: types.StringNull(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete event schedule, got error: %s", err))
+		return
+	}
+}
+
+func (r *eventScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), eventScheduleID)...)
+
+	// Automatically call r.Read
+}
+
+// getSchedule returns the dates of the event schedule set in CTFd.
+func (r *eventScheduleResource) getSchedule(ctx context.Context) (map[string]time.Time, error) {
+	configs, _, err := r.fm.Client.GetConfigs(ctx, &api.GetConfigsParams{}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		return nil, err
+	}
+	schedule := map[string]time.Time{}
+	for _, cfg := range configs {
+		switch cfg.Key {
+		case "start", "end", "freeze":
+		default:
+			continue
+		}
+		if cfg.Value == "" {
+			continue
+		}
+		// CTFd stores timestamps, possibly with a fractional part
+		ts, err := strconv.ParseFloat(cfg.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s timestamp %q: %w", cfg.Key, cfg.Value, err)
+		}
+		sec, frac := math.Modf(ts)
+		schedule[cfg.Key] = time.Unix(int64(sec), int64(frac*1e9))
+	}
+	return schedule, nil
+}
+
+// apply sets the dates of the event schedule, and removes the unset ones.
+func (r *eventScheduleResource) apply(ctx context.Context, data *eventScheduleResourceModel) error {
+	loc, err := data.location()
+	if err != nil {
+		return err
+	}
+	current, err := r.getSchedule(ctx)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]string{}
+	for _, k := range data.keys() {
+		if k.value.IsNull() {
+			if _, ok := current[k.key]; ok {
+				if _, err := r.fm.Client.DeleteConfigsByKey(ctx, k.key, WithTracerProvider(r.fm.Tp)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		t, err := validators.ParseDateTime(k.value.ValueString(), loc)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", k.key, err)
+		}
+		values[k.key] = strconv.FormatInt(t.Unix(), 10)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	_, err = r.fm.Client.PatchConfigs(ctx, values, WithTracerProvider(r.fm.Tp))
+	return err
+}
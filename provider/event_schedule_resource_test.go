@@ -0,0 +1,50 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_EventSchedule_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_event_schedule" "edition" {
+	start = "2030-11-14T08:00:00Z"
+	end   = "2030-11-15T17:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_event_schedule.edition", "id", "schedule"),
+					resource.TestCheckNoResourceAttr("ctfd_event_schedule.edition", "freeze"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "ctfd_event_schedule.edition",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_event_schedule" "edition" {
+	start    = "2030-11-14T09:00:00"
+	freeze   = "2030-11-15T16:00:00"
+	end      = "2030-11-15T18:00:00"
+	timezone = "Europe/Paris"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_event_schedule.edition", "start", "2030-11-14T09:00:00"),
+					resource.TestCheckResourceAttr("ctfd_event_schedule.edition", "freeze", "2030-11-15T16:00:00"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*fieldResource)(nil)
+	_ resource.ResourceWithConfigure   = (*fieldResource)(nil)
+	_ resource.ResourceWithImportState = (*fieldResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*fieldResource)(nil)
+)
+
+func NewFieldResource() resource.Resource {
+	return &fieldResource{}
+}
+
+type fieldResource struct {
+	fm *Framework
+}
+
+type fieldResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Type        types.String   `tfsdk:"type"`
+	FieldType   types.String   `tfsdk:"field_type"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Required    types.Bool     `tfsdk:"required"`
+	Public      types.Bool     `tfsdk:"public"`
+	Editable    types.Bool     `tfsdk:"editable"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *fieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field"
+}
+
+func (r *fieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A custom field filled in by the users or the teams on registration (e.g. a school, a student ID or a T-shirt size).\n\nIts values are set through the `fields` of the `ctfd_user` and `ctfd_team` resources.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the field, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the entities the field is filled in by, either \"user\" or \"team\".",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						types.StringValue("user"),
+						types.StringValue("team"),
+					}),
+				},
+			},
+			"field_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the field value, either \"text\" or \"boolean\" (a checkbox).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("text"),
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						types.StringValue("text"),
+						types.StringValue("boolean"),
+					}),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the field, displayed in the registration form.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the field, displayed in the registration form.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the field must be filled in on registration.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"public": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the field value is displayed on the public profile.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"editable": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the field value could be edited after registration.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *fieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *fieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_field")
+}
+
+func (r *fieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data fieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create field
+	res, _, err := r.fm.Client.PostConfigFields(ctx, &api.PostConfigFieldsParams{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		FieldType:   data.FieldType.ValueString(),
+		Editable:    data.Editable.ValueBool(),
+		Public:      data.Public.ValueBool(),
+		Required:    data.Required.ValueBool(),
+		Type:        data.Type.ValueString(),
+	}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create field, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a field")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *fieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data fieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, _, err := r.fm.Client.GetConfigsField(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read field %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Upsert values
+	data.Type = types.StringValue(res.Type)
+	data.FieldType = types.StringValue(fmt.Sprintf("%v", res.FieldType))
+	data.Name = types.StringPointerValue(res.Name)
+	data.Description = types.StringValue("")
+	if res.Description != nil {
+		data.Description = types.StringValue(*res.Description)
+	}
+	data.Required = types.BoolValue(res.Required)
+	data.Public = types.BoolValue(res.Public)
+	data.Editable = types.BoolValue(res.Editable)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *fieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data fieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update field
+	if _, _, err := r.fm.Client.PatchConfigsField(ctx, data.ID.ValueString(), &api.PatchConfigsFieldParams{
+		ID:          utils.Atoi(data.ID.ValueString()),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		FieldType:   data.FieldType.ValueString(),
+		Type:        data.Type.ValueString(),
+		Editable:    data.Editable.ValueBool(),
+		Public:      data.Public.ValueBool(),
+		Required:    data.Required.ValueBool(),
+	}, WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update field %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *fieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data fieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if _, err := r.fm.Client.DeleteConfigsField(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete field %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *fieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
+
+// expandFieldEntries returns the entries to set the custom fields values
+// of a user or a team, keyed by field identifier. Values of boolean fields
+// are converted, and the fields removed since prior are emptied, as CTFd
+// keeps the values of the fields it is not given.
+func expandFieldEntries(ctx context.Context, client *Client, fields, prior map[string]types.String, opts ...Option) ([]FieldEntry, error) {
+	entries := make([]FieldEntry, 0, len(fields)+len(prior))
+	for id, value := range fields {
+		field, _, err := client.GetConfigsField(ctx, id, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("getting field %s: %w", id, err)
+		}
+		entry := FieldEntry{
+			FieldID: field.ID,
+			Value:   value.ValueString(),
+		}
+		if field.FieldType == "boolean" {
+			b, err := strconv.ParseBool(value.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("field %s is a boolean, got %q", id, value.ValueString())
+			}
+			entry.Value = b
+		}
+		entries = append(entries, entry)
+	}
+	for id := range prior {
+		if _, ok := fields[id]; ok {
+			continue
+		}
+		entries = append(entries, FieldEntry{
+			FieldID: utils.Atoi(id),
+			Value:   "",
+		})
+	}
+	return entries, nil
+}
+
+// fieldsImportKey is the private state key marking a user or a team as
+// being imported, as its imported state manages no custom field.
+const fieldsImportKey = "fields_import"
+
+// markFieldsImport marks the user or team being imported, such that its next
+// read imports the values of all its custom fields.
+func markFieldsImport(ctx context.Context, private privateStateSetter) diag.Diagnostics {
+	return private.SetKey(ctx, fieldsImportKey, []byte("true"))
+}
+
+// readFieldEntries returns the custom fields values of a user or a team to
+// keep in state: all the non-empty ones once imported, else the ones of the
+// fields of state only (see refreshFieldEntries).
+func readFieldEntries(ctx context.Context, reqPrivate privateStateGetter, respPrivate privateStateSetter, state map[string]types.String, entries []FieldEntry) (map[string]types.String, diag.Diagnostics) {
+	b, diags := reqPrivate.GetKey(ctx, fieldsImportKey)
+	if diags.HasError() || len(b) == 0 {
+		return refreshFieldEntries(state, entries), diags
+	}
+	diags.Append(respPrivate.SetKey(ctx, fieldsImportKey, nil)...)
+
+	fields := flattenFieldEntries(entries)
+	if len(fields) == 0 {
+		return nil, diags
+	}
+	return fields, diags
+}
+
+// refreshFieldEntries returns the custom fields values of a user or a team
+// for the fields of state only, such that the values of the fields it does
+// not manage (e.g. set by players on registration) are neither imported nor
+// wiped. A nil state means the fields are not managed at all.
+func refreshFieldEntries(state map[string]types.String, entries []FieldEntry) map[string]types.String {
+	if state == nil {
+		return nil
+	}
+	values := flattenFieldEntries(entries)
+	fields := make(map[string]types.String, len(state))
+	for id := range state {
+		if value, ok := values[id]; ok {
+			fields[id] = value
+		}
+	}
+	return fields
+}
+
+// flattenFieldEntries returns the custom fields values of a user or a team,
+// keyed by field identifier. Empty values are skipped, as they are the
+// ones of removed fields.
+func flattenFieldEntries(entries []FieldEntry) map[string]types.String {
+	fields := map[string]types.String{}
+	for _, entry := range entries {
+		var value string
+		switch v := entry.Value.(type) {
+		case nil:
+			continue
+		case string:
+			value = v
+		case bool:
+			value = strconv.FormatBool(v)
+		default:
+			value = fmt.Sprintf("%v", v)
+		}
+		if value == "" {
+			continue
+		}
+		fields[strconv.Itoa(entry.FieldID)] = types.StringValue(value)
+	}
+	return fields
+}
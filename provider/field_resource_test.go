@@ -0,0 +1,128 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Field_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_field" "school" {
+	type     = "user"
+	name     = "School"
+	required = true
+}
+
+resource "ctfd_user" "player" {
+	name     = "player"
+	email    = "player@ctfer.io"
+	password = "password"
+
+	fields = {
+		(ctfd_field.school.id) = "ENSIMAG"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("ctfd_field.school", "id"),
+					resource.TestCheckResourceAttr("ctfd_field.school", "field_type", "text"),
+					resource.TestCheckResourceAttr("ctfd_user.player", "fields.%", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "ctfd_field.school",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing, with the values of all fields
+			{
+				ResourceName:            "ctfd_user.player",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"}, // password can't be fetched from CTFd (security by design)
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_field" "school" {
+	type        = "user"
+	name        = "School"
+	description = "The school you are studying in."
+	required    = true
+	public      = true
+}
+
+resource "ctfd_field" "newsletter" {
+	type       = "user"
+	field_type = "boolean"
+	name       = "Newsletter"
+	editable   = true
+}
+
+resource "ctfd_user" "player" {
+	name     = "player"
+	email    = "player@ctfer.io"
+	password = "password"
+
+	fields = {
+		(ctfd_field.school.id)     = "Grenoble INP"
+		(ctfd_field.newsletter.id) = "true"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_field.school", "public", "true"),
+					resource.TestCheckResourceAttr("ctfd_user.player", "fields.%", "2"),
+				),
+			},
+			// Remove a field value, and add a user not managing its fields
+			{
+				Config: providerConfig + `
+resource "ctfd_field" "school" {
+	type        = "user"
+	name        = "School"
+	description = "The school you are studying in."
+	required    = true
+	public      = true
+}
+
+resource "ctfd_field" "newsletter" {
+	type       = "user"
+	field_type = "boolean"
+	name       = "Newsletter"
+	editable   = true
+}
+
+resource "ctfd_user" "player" {
+	name     = "player"
+	email    = "player@ctfer.io"
+	password = "password"
+
+	fields = {
+		(ctfd_field.school.id) = "Grenoble INP"
+	}
+}
+
+resource "ctfd_user" "other" {
+	name     = "other"
+	email    = "other@ctfer.io"
+	password = "password"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_user.player", "fields.%", "1"),
+					resource.TestCheckNoResourceAttr("ctfd_user.other", "fields.%"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
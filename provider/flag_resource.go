@@ -8,6 +8,7 @@ import (
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = (*flagResource)(nil)
 	_ resource.ResourceWithConfigure   = (*flagResource)(nil)
 	_ resource.ResourceWithImportState = (*flagResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*flagResource)(nil)
 )
 
 func NewFlagResource() resource.Resource {
@@ -40,6 +42,8 @@ type flagResourceModel struct {
 	Content     types.String `tfsdk:"content"`
 	Data        types.String `tfsdk:"data"`
 	Type        types.String `tfsdk:"type"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *flagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -96,6 +100,9 @@ func (r *flagResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -117,6 +124,14 @@ func (r *flagResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.fm = fm
 }
 
+func (r *flagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_flag")
+}
+
 func (r *flagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
@@ -127,6 +142,14 @@ func (r *flagResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create flag
 	res, _, err := r.fm.Client.PostFlags(ctx, &api.PostFlagsParams{
 		Challenge: utils.Atoi(data.ChallengeID.ValueString()),
@@ -163,6 +186,14 @@ func (r *flagResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Retrieve flag
 	res, _, err := r.fm.Client.GetFlag(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
 	if err != nil {
@@ -195,6 +226,14 @@ func (r *flagResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update flag
 	if _, _, err := r.fm.Client.PatchFlag(ctx, data.ID.ValueString(), &api.PatchFlagParams{
 		ID:      data.ID.ValueString(),
@@ -225,6 +264,14 @@ func (r *flagResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteFlag(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete flag %s, got error: %s", data.ID.ValueString(), err))
 		return
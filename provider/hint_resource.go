@@ -7,6 +7,7 @@ import (
 
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = (*hintResource)(nil)
 	_ resource.ResourceWithConfigure   = (*hintResource)(nil)
 	_ resource.ResourceWithImportState = (*hintResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*hintResource)(nil)
 )
 
 func NewHintResource() resource.Resource {
@@ -41,6 +43,8 @@ type hintResourceModel struct {
 	Content      types.String   `tfsdk:"content"`
 	Cost         types.Int64    `tfsdk:"cost"`
 	Requirements []types.String `tfsdk:"requirements"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *hintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,6 +88,9 @@ func (r *hintResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -105,6 +112,25 @@ func (r *hintResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.fm = fm
 }
 
+func (r *hintResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_hint")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var title types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("title"), &title)...)
+	if !title.IsNull() {
+		r.fm.Capabilities.Require(&resp.Diagnostics, path.Root("title"), "`ctfd_hint` title", CapabilityHintTitle)
+	}
+}
+
 func (r *hintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
@@ -115,6 +141,14 @@ func (r *hintResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create hint
 	reqs := make([]int, 0, len(data.Requirements))
 	for _, preq := range data.Requirements {
@@ -159,6 +193,14 @@ func (r *hintResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Retrieve hint
 	h, _, err := r.fm.Client.GetHint(ctx, data.ID.ValueString(), &api.GetHintParams{
 		Preview: utils.Ptr(true), // mimic a preview to get the hint even if not unlocked by the admin
@@ -214,6 +256,14 @@ func (r *hintResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update hint
 	preqs := make([]int, 0, len(data.Requirements))
 	for _, preq := range data.Requirements {
@@ -252,6 +302,14 @@ func (r *hintResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteHint(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hint %s, got error: %s", data.ID.ValueString(), err))
 		return
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = (*identityDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*identityDataSource)(nil)
+)
+
+func NewIdentityDataSource() datasource.DataSource {
+	return &identityDataSource{}
+}
+
+type identityDataSource struct {
+	fm *Framework
+}
+
+type identityDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Type  types.String `tfsdk:"type"`
+	Admin types.Bool   `tfsdk:"admin"`
+}
+
+func (data *identityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+func (data *identityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The CTFd account the provider is authenticated with.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name or pseudo of the user.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the user.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Generic type for RBAC purposes, either user or admin.",
+				Computed:            true,
+			},
+			"admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is an admin.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (data *identityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	data.fm = fm
+}
+
+func (data *identityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, data.fm.Tp.Tracer(serviceName), data)
+	defer span.End()
+
+	user, err := GetIdentity(ctx, data.fm.Client, WithTracerProvider(data.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read CTFd Identity",
+			err.Error(),
+		)
+		return
+	}
+
+	state := identityDataSourceModel{
+		ID:    types.StringValue(strconv.Itoa(user.ID)),
+		Name:  types.StringValue(user.Name),
+		Email: types.StringPointerValue(user.Email),
+		Type:  types.StringPointerValue(user.Type),
+		Admin: types.BoolValue(IsAdmin(user)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// GetIdentity returns the user the client is authenticated as.
+// As CTFd does not return the type of the current user, it is then
+// fetched with the admin view, which only admins get.
+func GetIdentity(ctx context.Context, client *Client, opts ...Option) (*api.User, error) {
+	me, _, err := client.GetUsersMe(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	user, _, err := client.GetUser(ctx, strconv.Itoa(me.ID), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &user.User, nil
+}
+
+// IsAdmin returns whether the user is an admin.
+func IsAdmin(user *api.User) bool {
+	return user.Type != nil && *user.Type == "admin"
+}
@@ -0,0 +1,28 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_IdentityDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "ctfd_identity" "me" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The admin created on setup is the first user
+					resource.TestCheckResourceAttr("data.ctfd_identity.me", "id", "1"),
+					resource.TestCheckResourceAttr("data.ctfd_identity.me", "name", "ctfer"),
+					resource.TestCheckResourceAttr("data.ctfd_identity.me", "email", "ctfer-io@protonmail.com"),
+					resource.TestCheckResourceAttr("data.ctfd_identity.me", "type", "admin"),
+					resource.TestCheckResourceAttr("data.ctfd_identity.me", "admin", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Limits bounds the load put on CTFd by the API calls.
+type Limits struct {
+	// MaxConcurrentRequests is the maximum number of in-flight requests.
+	// Zero means unlimited.
+	MaxConcurrentRequests int
+	// RequestsPerSecond is the maximum rate at which requests are issued.
+	// Zero means unlimited.
+	RequestsPerSecond float64
+}
+
+// limiter enforces Limits, shared among all the API calls it is given to.
+type limiter struct {
+	slots chan struct{}
+
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(limits Limits) *limiter {
+	l := &limiter{}
+	if limits.MaxConcurrentRequests > 0 {
+		l.slots = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+	if limits.RequestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / limits.RequestsPerSecond)
+	}
+	return l
+}
+
+// acquire waits for a concurrency slot. The returned function releases it.
+func (l *limiter) acquire(ctx context.Context) (release func(), err error) {
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return sync.OnceFunc(func() {
+		<-l.slots
+	}), nil
+}
+
+// wait waits for the rate to allow a new request.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.interval <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait := time.Until(at); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return nil
+}
+
+// limitTransport issues requests once its limiter has a concurrency slot
+// for them, and holds it until the response body is closed.
+// The time waited is recorded as an attribute of the current span.
+type limitTransport struct {
+	next    http.RoundTripper
+	limiter *limiter
+}
+
+var _ http.RoundTripper = (*limitTransport)(nil)
+
+func (rt *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	start := time.Now()
+	release, err := rt.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.Int64("limiter.wait_ms", time.Since(start).Milliseconds()),
+	)
+
+	res, err := rt.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	res.Body = &releaseBody{
+		ReadCloser: res.Body,
+		release:    release,
+	}
+	return res, nil
+}
+
+// rateTransport issues requests once the rate of its limiter allows it.
+// The time waited is recorded as an event of the current span.
+type rateTransport struct {
+	next    http.RoundTripper
+	limiter *limiter
+}
+
+var _ http.RoundTripper = (*rateTransport)(nil)
+
+func (rt *rateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	start := time.Now()
+	if err := rt.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	if waited := time.Since(start); waited > time.Millisecond {
+		trace.SpanFromContext(ctx).AddEvent("ratelimit", trace.WithAttributes(
+			attribute.Int64("limiter.wait_ms", waited.Milliseconds()),
+		))
+	}
+	return rt.next.RoundTrip(req)
+}
+
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiter_RetriesComplyWithRate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		times []time.Time
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		n := len(times)
+		mu.Unlock()
+		if n < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	interval := 50 * time.Millisecond
+	tp := apiTransport(
+		WithLimits(Limits{RequestsPerSecond: float64(time.Second / interval)}),
+		WithRetryPolicy(RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+		}),
+	)
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	res, err := tp.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+
+	if len(times) != 3 {
+		t.Fatalf("got %d attempts, want 3", len(times))
+	}
+	// Leave some slack for the timer resolution
+	for i := 1; i < len(times); i++ {
+		if d := times[i].Sub(times[i-1]); d < interval-5*time.Millisecond {
+			t.Errorf("attempt %d was issued %s after the previous one, want at least %s", i+1, d, interval)
+		}
+	}
+}
+
+func TestLimiter_RetriesHoldSlot(t *testing.T) {
+	t.Parallel()
+
+	var inflight, peak atomic.Int32
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		// Every first attempt is ratelimited
+		if calls.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	tp := apiTransport(
+		WithLimits(Limits{MaxConcurrentRequests: 1}),
+		WithRetryPolicy(RetryPolicy{
+			MaxAttempts: 2,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+		}),
+	)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+			res, err := tp.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if res.StatusCode != http.StatusOK {
+				t.Errorf("got status %d, want %d", res.StatusCode, http.StatusOK)
+			}
+			_ = res.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if p := peak.Load(); p != 1 {
+		t.Errorf("got %d concurrent requests, want 1", p)
+	}
+}
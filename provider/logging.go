@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBody is the maximum size of the bodies logged, larger ones
+// are omitted.
+const maxLoggedBody = 64 << 10
+
+// redacted replaces the sensitive values in logs.
+const redacted = "***"
+
+// sensitiveHeaders are masked whenever logged, by their field key.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Csrf-Token",
+}
+
+// sensitiveKeys are the JSON and form keys whose string values are
+// masked in logged bodies, e.g. flags content, nonces or sessions.
+var sensitiveKeys = map[string]struct{}{
+	"confirm": {},
+	"content": {},
+	"nonce":   {},
+	"session": {},
+	"value":   {},
+}
+
+// sensitiveKeyParts mask the values of the keys containing them, e.g.
+// passwords or API tokens whatever their prefix (mail_password,
+// mailgun_api_key...).
+var sensitiveKeyParts = []string{
+	"api_key",
+	"password",
+	"secret",
+	"token",
+}
+
+// isSensitiveKey returns whether the value of a JSON or form key is
+// to be masked.
+func isSensitiveKey(k string) bool {
+	k = strings.ToLower(k)
+	if _, ok := sensitiveKeys[k]; ok {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(k, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// logTransport logs every request and its response at debug level,
+// i.e. with TF_LOG=debug, with the sensitive values masked.
+type logTransport struct {
+	next http.RoundTripper
+}
+
+var _ http.RoundTripper = (*logTransport)(nil)
+
+func (rt *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	ctx = tflog.SetField(ctx, "http.method", req.Method)
+	ctx = tflog.SetField(ctx, "http.path", req.URL.Path)
+	ctx = logHeaders(ctx, "http.request.header.", req.Header)
+
+	req, err := replayable(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(io.LimitReader(body, maxLoggedBody+1))
+			_ = body.Close()
+			ctx = tflog.SetField(ctx, "http.request.body", logRequestBody(req, b))
+		}
+	}
+
+	start := time.Now()
+	res, err := rt.next.RoundTrip(req)
+	ctx = tflog.SetField(ctx, "http.latency_ms", time.Since(start).Milliseconds())
+	if err != nil {
+		tflog.Debug(ctx, "CTFd API call failed", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	// Peek the body so that it could still be read entirely
+	br := bufio.NewReaderSize(res.Body, maxLoggedBody+1)
+	peek, _ := br.Peek(maxLoggedBody + 1)
+	res.Body = &peekedBody{
+		Reader: br,
+		Closer: res.Body,
+	}
+
+	ctx = tflog.SetField(ctx, "http.status_code", res.StatusCode)
+	ctx = logHeaders(ctx, "http.response.header.", res.Header)
+	ctx = tflog.SetField(ctx, "http.response.body", logBody(res.Header, peek))
+	tflog.Debug(ctx, "CTFd API call")
+
+	return res, nil
+}
+
+type peekedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// logHeaders sets the headers as fields, masking the sensitive ones.
+func logHeaders(ctx context.Context, prefix string, header http.Header) context.Context {
+	for k, v := range header {
+		ctx = tflog.SetField(ctx, prefix+strings.ToLower(k), strings.Join(v, ", "))
+	}
+	keys := make([]string, 0, len(sensitiveHeaders))
+	for _, h := range sensitiveHeaders {
+		keys = append(keys, prefix+strings.ToLower(h))
+	}
+	return tflog.MaskFieldValuesWithFieldKeys(ctx, keys...)
+}
+
+// logRequestBody returns the body of the request to log, with the sensitive
+// values redacted. The values of a configs PATCH are all redacted, as the
+// secrets (e.g. mail_password) are set along the other configs.
+func logRequestBody(req *http.Request, b []byte) string {
+	if req.Method != http.MethodPatch || !strings.HasSuffix(req.URL.Path, "/api/v1/configs") {
+		return logBody(req.Header, b)
+	}
+	if len(b) > maxLoggedBody {
+		return "(body too large to be logged)"
+	}
+	var configs map[string]any
+	if err := json.Unmarshal(b, &configs); err != nil {
+		return "(invalid JSON body)"
+	}
+	for k := range configs {
+		configs[k] = redacted
+	}
+	out, _ := json.Marshal(configs)
+	return string(out)
+}
+
+// logBody returns the body to log, with the sensitive values redacted.
+// Only JSON and form bodies are logged, as other ones (e.g. files, or HTML
+// pages embedding the CSRF nonce) could be binary or not structured enough
+// to be redacted.
+func logBody(header http.Header, b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	if len(b) > maxLoggedBody {
+		return "(body too large to be logged)"
+	}
+
+	mt, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch mt {
+	case "application/json":
+		var v any
+		if err := json.Unmarshal(b, &v); err != nil {
+			return "(invalid JSON body)"
+		}
+		out, _ := json.Marshal(redactJSON(v))
+		return string(out)
+
+	case "application/x-www-form-urlencoded":
+		vals, err := url.ParseQuery(string(b))
+		if err != nil {
+			return "(invalid form body)"
+		}
+		for k := range vals {
+			if isSensitiveKey(k) {
+				vals[k] = []string{redacted}
+			}
+		}
+		// Keep the mask readable, rather than URL-encoded
+		return strings.ReplaceAll(vals.Encode(), url.QueryEscape(redacted), redacted)
+
+	default:
+		return fmt.Sprintf("(%d bytes body of type %q omitted)", len(b), mt)
+	}
+}
+
+// redactJSON masks the string values of the sensitive keys, recursively.
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, sub := range v {
+			if _, ok := sub.(string); ok && isSensitiveKey(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactJSON(sub)
+		}
+	case []any:
+		for i, sub := range v {
+			v[i] = redactJSON(sub)
+		}
+	}
+	return v
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestIsSensitiveKey(t *testing.T) {
+	t.Parallel()
+
+	for k, want := range map[string]bool{
+		"password":        true,
+		"Password":        true,
+		"mail_password":   true,
+		"mailgun_api_key": true,
+		"api_key":         true,
+		"client_secret":   true,
+		"oauth_secret":    true,
+		"token":           true,
+		"access_token":    true,
+		"content":         true,
+		"nonce":           true,
+		"value":           true,
+		"name":            false,
+		"email":           false,
+		"contents":        false,
+		"description":     false,
+	} {
+		if got := isSensitiveKey(k); got != want {
+			t.Errorf("%q: got %t, want %t", k, got, want)
+		}
+	}
+}
+
+func TestLogBody(t *testing.T) {
+	t.Parallel()
+
+	jsonHeader := http.Header{"Content-Type": []string{"application/json"}}
+	formHeader := http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}}
+
+	tests := map[string]struct {
+		header http.Header
+		body   string
+		want   string
+	}{
+		"empty": {
+			header: jsonHeader,
+			body:   "",
+			want:   "",
+		},
+		"json": {
+			header: jsonHeader,
+			body:   `{"name":"ctfer","password":"ctfer","mail_password":"s3cr3t","mailgun_api_key":"key-123","oauth_client_secret":"abc"}`,
+			want:   `{"mail_password":"***","mailgun_api_key":"***","name":"ctfer","oauth_client_secret":"***","password":"***"}`,
+		},
+		"json-nested": {
+			header: jsonHeader,
+			body:   `{"success":true,"data":[{"id":1,"content":"CTF{flag}","type":"static"},{"id":2,"access_token":"ctfd_abc"}]}`,
+			want:   `{"data":[{"content":"***","id":1,"type":"static"},{"access_token":"***","id":2}],"success":true}`,
+		},
+		"json-non-string": {
+			header: jsonHeader,
+			body:   `{"token":null,"value":42}`,
+			want:   `{"token":null,"value":42}`,
+		},
+		"json-invalid": {
+			header: jsonHeader,
+			body:   `{"password":`,
+			want:   "(invalid JSON body)",
+		},
+		"form": {
+			header: formHeader,
+			body:   "name=ctfer&password=ctfer&nonce=0123",
+			want:   "name=ctfer&nonce=***&password=***",
+		},
+		"html": {
+			header: http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+			body:   `<script>var csrfNonce = "0123";</script>`,
+			want:   `(40 bytes body of type "text/html" omitted)`,
+		},
+		"too-large": {
+			header: jsonHeader,
+			body:   strings.Repeat("a", maxLoggedBody+1),
+			want:   "(body too large to be logged)",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := logBody(tt.header, []byte(tt.body)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogTransport_ConfigsPatch(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &out)
+
+	body := `{"mail_server":"smtp.ctfer.io","mail_username":"ctfd","mail_password":"s3cr3t","mailgun_api_key":"key-123"}`
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPatch, srv.URL+"/api/v1/configs", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	res, err := (&logTransport{next: http.DefaultTransport}).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+
+	logged := out.String()
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1", len(entries))
+	}
+	want := `{"mail_password":"***","mail_server":"***","mail_username":"***","mailgun_api_key":"***"}`
+	if got := entries[0]["http.request.body"]; got != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+	for _, secret := range []string{"s3cr3t", "key-123"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("secret %q is logged", secret)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrNotFound is returned by API calls made with withNotFound when the
+// requested object does not exist (anymore) in CTFd.
+var ErrNotFound = errors.New("CTFd object not found")
+
+// notFoundTransport turns the 404 responses into ErrNotFound, as the
+// underlying client does not expose the status code.
+type notFoundTransport struct {
+	next http.RoundTripper
+}
+
+var _ http.RoundTripper = (*notFoundTransport)(nil)
+
+func (rt *notFoundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := rt.next.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusNotFound {
+		return res, err
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+	return nil, fmt.Errorf("%w: %s %s", ErrNotFound, req.Method, req.URL.Path)
+}
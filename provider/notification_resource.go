@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*notificationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*notificationResource)(nil)
+	_ resource.ResourceWithImportState = (*notificationResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*notificationResource)(nil)
+)
+
+const (
+	// defaultNotificationType and defaultNotificationSound are the defaults
+	// of the CTFd admin panel. They are also used on import, as CTFd does
+	// not store them.
+	defaultNotificationType  = "toast"
+	defaultNotificationSound = true
+)
+
+func NewNotificationResource() resource.Resource {
+	return &notificationResource{}
+}
+
+type notificationResource struct {
+	fm *Framework
+}
+
+type notificationResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Title    types.String   `tfsdk:"title"`
+	Content  types.String   `tfsdk:"content"`
+	Type     types.String   `tfsdk:"type"`
+	Sound    types.Bool     `tfsdk:"sound"`
+	UserID   types.String   `tfsdk:"user_id"`
+	TeamID   types.String   `tfsdk:"team_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *notificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+func (r *notificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A notification sent to the players, e.g. to announce a new wave of challenges.\n\nIt is sent once created, so CTFd does not permit updating it: any change requires a replacement, i.e. sending it again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the notification, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the notification.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the notification, in markdown.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "How the notification is displayed to the players connected when it is sent, either \"toast\", \"alert\" or \"background\" (not displayed). Default to \"" + defaultNotificationType + "\".",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultNotificationType),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						types.StringValue("toast"),
+						types.StringValue("alert"),
+						types.StringValue("background"),
+					}),
+				},
+			},
+			"sound": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the notification plays a sound when displayed. Default to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaultNotificationSound),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User the notification is sent to. Conflicts with `team_id`. If none set, it is sent to all players.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team the notification is sent to. Conflicts with `user_id`. If none set, it is sent to all players.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *notificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *notificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_notification")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.UserID.IsNull() && !data.TeamID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_id"),
+			"Invalid notification target",
+			"A notification could be sent either to a user or to a team, not both.",
+		)
+	}
+}
+
+func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	params := &api.PostNotificationsParams{
+		Title:   data.Title.ValueString(),
+		Content: data.Content.ValueString(),
+		Type:    data.Type.ValueString(),
+		Sound:   data.Sound.ValueBool(),
+	}
+	if !data.UserID.IsNull() {
+		params.UserID = utils.Ptr(utils.Atoi(data.UserID.ValueString()))
+	}
+	if !data.TeamID.IsNull() {
+		params.TeamID = utils.Ptr(utils.Atoi(data.TeamID.ValueString()))
+	}
+
+	// Send notification
+	res, _, err := r.fm.Client.PostNotifications(ctx, params, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create notification, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a notification")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, _, err := r.fm.Client.GetNotification(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read notification %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Upsert values, the type and sound are not stored by CTFd so are kept as is
+	data.Title = types.StringValue(res.Title)
+	data.Content = types.StringValue(res.Content)
+	data.UserID = types.StringNull()
+	if res.UserID != nil {
+		data.UserID = types.StringValue(strconv.Itoa(*res.UserID))
+	}
+	data.TeamID = types.StringNull()
+	if res.TeamID != nil {
+		data.TeamID = types.StringValue(strconv.Itoa(*res.TeamID))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes require a replacement, so only the timeouts could
+	// have changed
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if _, err := r.fm.Client.DeleteNotification(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), defaultNotificationType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sound"), defaultNotificationSound)...)
+
+	// Automatically call r.Read
+}
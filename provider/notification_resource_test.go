@@ -0,0 +1,58 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Notification_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_notification" "wave" {
+	title   = "New challenges"
+	content = "A new wave of challenges is out!"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("ctfd_notification.wave", "id"),
+					resource.TestCheckResourceAttr("ctfd_notification.wave", "type", "toast"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "ctfd_notification.wave",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_user" "player" {
+	name     = "player"
+	email    = "player@ctfer.io"
+	password = "password"
+}
+
+resource "ctfd_notification" "wave" {
+	title   = "New challenges"
+	content = "A new wave of challenges is out, hurry up!"
+	type    = "alert"
+	sound   = false
+	user_id = ctfd_user.player.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_notification.wave", "type", "alert"),
+					resource.TestCheckResourceAttrPair("ctfd_notification.wave", "user_id", "ctfd_user.player", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
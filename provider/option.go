@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"net/http"
+	"time"
+
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -10,7 +13,13 @@ type Option interface {
 }
 
 type options struct {
-	tracer trace.TracerProvider
+	tracer    trace.TracerProvider
+	retry     *RetryPolicy
+	transport http.RoundTripper
+	limiter   *limiter
+	timeout   time.Duration
+	readOnly  bool
+	notFound  bool
 }
 
 type tracerOption struct {
@@ -27,13 +36,112 @@ func WithTracerProvider(tracer trace.TracerProvider) Option {
 	}
 }
 
-func getTracer(opts ...Option) trace.Tracer {
+type retryOption struct {
+	policy RetryPolicy
+}
+
+func (opt retryOption) apply(opts *options) {
+	opts.retry = &opt.policy
+}
+
+// WithRetryPolicy defines how API calls are retried on rate limits
+// and transient errors. If none set, calls are not retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return &retryOption{
+		policy: policy,
+	}
+}
+
+type transportOption struct {
+	transport http.RoundTripper
+}
+
+func (opt transportOption) apply(opts *options) {
+	opts.transport = opt.transport
+}
+
+// WithTransport specifies the HTTP transport to reach CTFd with.
+// If none set, default to an instrumented [http.DefaultTransport].
+func WithTransport(transport http.RoundTripper) Option {
+	return &transportOption{
+		transport: transport,
+	}
+}
+
+type limitsOption struct {
+	limiter *limiter
+}
+
+func (opt limitsOption) apply(opts *options) {
+	opts.limiter = opt.limiter
+}
+
+// WithLimits bounds the concurrency and rate of the API calls.
+// The limits are shared among all the calls given the returned Option.
+func WithLimits(limits Limits) Option {
+	return &limitsOption{
+		limiter: newLimiter(limits),
+	}
+}
+
+type requestTimeoutOption struct {
+	timeout time.Duration
+}
+
+func (opt requestTimeoutOption) apply(opts *options) {
+	opts.timeout = opt.timeout
+}
+
+// WithRequestTimeout bounds the duration of every attempt of the API calls.
+// If none set, calls are only bounded by their context.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return &requestTimeoutOption{
+		timeout: timeout,
+	}
+}
+
+type readOnlyOption struct{}
+
+func (opt readOnlyOption) apply(opts *options) {
+	opts.readOnly = true
+}
+
+// WithReadOnly makes all mutating API calls fail with ErrReadOnly.
+// Login is still possible.
+func WithReadOnly() Option {
+	return &readOnlyOption{}
+}
+
+type notFoundOption struct{}
+
+func (opt notFoundOption) apply(opts *options) {
+	opts.notFound = true
+}
+
+// withNotFound makes the API calls fail with ErrNotFound when CTFd
+// responds with a 404, e.g. for objects deleted out of Terraform.
+func withNotFound() Option {
+	return &notFoundOption{}
+}
+
+func getOptions(opts ...Option) *options {
 	o := &options{
-		tracer: nil,
+		tracer:    nil,
+		retry:     nil,
+		transport: nil,
+		limiter:   nil,
+		timeout:   0,
+		readOnly:  false,
+		notFound:  false,
 	}
 	for _, opt := range opts {
 		opt.apply(o)
 	}
+	return o
+}
+
+func getTracer(opts ...Option) trace.Tracer {
+	o := getOptions(opts...)
 
 	if o.tracer == nil {
 		o.tracer = otel.GetTracerProvider()
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = (*pageDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*pageDataSource)(nil)
+)
+
+func NewPageDataSource() datasource.DataSource {
+	return &pageDataSource{}
+}
+
+type pageDataSource struct {
+	fm *Framework
+}
+
+type pagesDataSourceModel struct {
+	ID    types.String        `tfsdk:"id"`
+	Pages []pageResourceModel `tfsdk:"pages"`
+}
+
+func (data *pageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pages"
+}
+
+func (data *pageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The custom pages of the CTFd website, including their content.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"pages": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the page, used internally to handle the CTFd corresponding object.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the page, displayed in the navigation bar.",
+							Computed:            true,
+						},
+						"route": schema.StringAttribute{
+							MarkdownDescription: "Route the page is served at, without leading slash (e.g. `rules` for `https://my-ctf.lan/rules`).",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Content of the page, in the `format`.",
+							Computed:            true,
+						},
+						"format": schema.StringAttribute{
+							MarkdownDescription: "Format of the content, either \"markdown\" or \"html\".",
+							Computed:            true,
+						},
+						"draft": schema.BoolAttribute{
+							MarkdownDescription: "Is true if the page is a draft, i.e. not served yet.",
+							Computed:            true,
+						},
+						"hidden": schema.BoolAttribute{
+							MarkdownDescription: "Is true if the page is hidden from the navigation bar, while still served at its route.",
+							Computed:            true,
+						},
+						"auth_required": schema.BoolAttribute{
+							MarkdownDescription: "Is true if the page is only served to logged in users.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (data *pageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	data.fm = fm
+}
+
+func (data *pageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, data.fm.Tp.Tracer(serviceName), data)
+	defer span.End()
+
+	var state pagesDataSourceModel
+
+	pages, _, err := data.fm.Client.GetPages(ctx, &api.GetPagesParams{}, WithTracerProvider(data.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read CTFd Pages",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Pages = make([]pageResourceModel, 0, len(pages))
+	for _, p := range pages {
+		// The content is not listed, so get it page by page
+		page, _, err := data.fm.Client.GetPage(ctx, strconv.Itoa(p.ID), WithTracerProvider(data.fm.Tp))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read CTFd Page",
+				fmt.Sprintf("Unable to read page %d, got error: %s", p.ID, err),
+			)
+			return
+		}
+
+		// Flatten response
+		state.Pages = append(state.Pages, flattenPage(page))
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*pageResource)(nil)
+	_ resource.ResourceWithConfigure   = (*pageResource)(nil)
+	_ resource.ResourceWithImportState = (*pageResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*pageResource)(nil)
+)
+
+func NewPageResource() resource.Resource {
+	return &pageResource{}
+}
+
+type pageResource struct {
+	fm *Framework
+}
+
+type pageResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Title        types.String `tfsdk:"title"`
+	Route        types.String `tfsdk:"route"`
+	Content      types.String `tfsdk:"content"`
+	Format       types.String `tfsdk:"format"`
+	Draft        types.Bool   `tfsdk:"draft"`
+	Hidden       types.Bool   `tfsdk:"hidden"`
+	AuthRequired types.Bool   `tfsdk:"auth_required"`
+}
+
+type pageResourceModelWithTimeouts struct {
+	pageResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *pageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_page"
+}
+
+func (r *pageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A custom page of the CTFd website, e.g. the rules, a FAQ or the sponsors.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the page, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the page, displayed in the navigation bar.",
+				Required:            true,
+			},
+			"route": schema.StringAttribute{
+				MarkdownDescription: "Route the page is served at, without leading slash (e.g. `rules` for `https://my-ctf.lan/rules`). Must be unique.",
+				Required:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the page, in the `format`.",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of the content, either \"markdown\" or \"html\".",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("markdown"),
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						types.StringValue("markdown"),
+						types.StringValue("html"),
+					}),
+				},
+			},
+			"draft": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the page is a draft, i.e. not served yet.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"hidden": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the page is hidden from the navigation bar, while still served at its route.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"auth_required": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the page is only served to logged in users.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *pageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *pageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_page")
+}
+
+func (r *pageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data pageResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create page
+	res, _, err := r.fm.Client.PostPages(ctx, &api.PostPagesParams{
+		Title:        data.Title.ValueString(),
+		Route:        data.Route.ValueString(),
+		Content:      data.Content.ValueString(),
+		Format:       data.Format.ValueString(),
+		Draft:        data.Draft.ValueBool(),
+		Hidden:       data.Hidden.ValueBool(),
+		AuthRequired: data.AuthRequired.ValueBool(),
+	}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create page, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a page")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data pageResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, _, err := r.fm.Client.GetPage(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read page %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Upsert values
+	data.pageResourceModel = flattenPage(res)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data pageResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update page
+	if _, _, err := r.fm.Client.PatchPage(ctx, data.ID.ValueString(), &api.PatchPageParams{
+		Title:        data.Title.ValueString(),
+		Route:        data.Route.ValueString(),
+		Content:      data.Content.ValueString(),
+		Format:       data.Format.ValueString(),
+		Draft:        data.Draft.ValueBool(),
+		Hidden:       data.Hidden.ValueBool(),
+		AuthRequired: data.AuthRequired.ValueBool(),
+	}, WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update page %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data pageResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if _, err := r.fm.Client.DeletePage(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete page %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *pageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
+
+// flattenPage returns the model of a page. Its content is empty if not
+// returned by CTFd, e.g. when listing pages.
+func flattenPage(page *api.Page) pageResourceModel {
+	content := ""
+	if page.Content != nil {
+		content = *page.Content
+	}
+	return pageResourceModel{
+		ID:           types.StringValue(strconv.Itoa(page.ID)),
+		Title:        types.StringValue(page.Title),
+		Route:        types.StringValue(page.Route),
+		Content:      types.StringValue(content),
+		Format:       types.StringValue(page.Format),
+		Draft:        types.BoolValue(page.Draft),
+		Hidden:       types.BoolValue(page.Hidden),
+		AuthRequired: types.BoolValue(page.AuthRequired),
+	}
+}
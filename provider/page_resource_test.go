@@ -0,0 +1,61 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Page_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_page" "rules" {
+	title   = "Rules"
+	route   = "rules"
+	content = "# Rules\n\nDo not attack the infrastructure."
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("ctfd_page.rules", "id"),
+					resource.TestCheckResourceAttr("ctfd_page.rules", "format", "markdown"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "ctfd_page.rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_page" "rules" {
+	title         = "Rules"
+	route         = "rules"
+	content       = "<h1>Rules</h1><p>Do not attack the infrastructure.</p>"
+	format        = "html"
+	auth_required = true
+}
+
+data "ctfd_pages" "all" {
+	depends_on = [ctfd_page.rules]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_page.rules", "format", "html"),
+					resource.TestCheckResourceAttr("ctfd_page.rules", "auth_required", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ctfd_pages.all", "pages.*", map[string]string{
+						"route":   "rules",
+						"content": "<h1>Rules</h1><p>Do not attack the infrastructure.</p>",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
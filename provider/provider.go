@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.opentelemetry.io/otel"
@@ -47,6 +50,33 @@ type CTFdProviderModel struct {
 	APIKey   types.String `tfsdk:"api_key"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+	Retry    *retryModel  `tfsdk:"retry"`
+
+	APIKeyFile        types.String `tfsdk:"api_key_file"`
+	PasswordFile      types.String `tfsdk:"password_file"`
+	CredentialProcess types.String `tfsdk:"credential_process"`
+	Anonymous         types.Bool   `tfsdk:"anonymous"`
+
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	Headers  types.Map    `tfsdk:"headers"`
+	ProxyURL types.String `tfsdk:"proxy_url"`
+
+	SessionCacheDir types.String `tfsdk:"session_cache_dir"`
+
+	WaitForReady *waitForReadyModel `tfsdk:"wait_for_ready"`
+
+	DefaultTags   types.Set `tfsdk:"default_tags"`
+	DefaultTopics types.Set `tfsdk:"default_topics"`
+
+	ReadOnly types.Bool `tfsdk:"read_only"`
 }
 
 func (p *CTFdProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -79,6 +109,28 @@ This could lead to unexpected failures under intensive work.
 !> **Warning:** Hard-coded credentials are not recommended in any Terraform
 configuration and risks secret leakage should this file ever be committed to a
 public version control system.
+
+Credentials could also be read from files (` + "`api_key_file`" + ` and ` + "`password_file`" + `), e.g. secrets
+mounted into CI runners, or from the JSON output of a ` + "`credential_process`" + ` command.
+Attributes take precedence over files, which take precedence over the credential process,
+which takes precedence over environment variables.
+
+## Deploying CTFd in the same configuration
+
+If the provider configuration depends on values that are only known after apply
+(e.g. the URL of a CTFd instance deployed in the same root module), resources and
+data sources are deferred until they are known, using Terraform deferred actions
+(e.g. ` + "`terraform plan -allow-deferral`" + `).
+
+A fresh instance could be bootstrapped with the ` + "`ctfd_setup`" + ` resource, using a provider
+configured with only the ` + "`url`" + ` and ` + "`anonymous = true`" + `. Its ` + "`api_key`" + ` is then used to configure the provider
+managing the other resources.
+
+## Troubleshooting
+
+With ` + "`TF_LOG=debug`" + `, every API call is logged with its method, path, status, latency
+and bodies. Secrets (e.g. flags content, passwords, session cookies and API keys) are
+masked, and files content is omitted.
 `,
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
@@ -86,7 +138,7 @@ public version control system.
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "User API key. Could use `CTFD_API_KEY` environment variable instead. Despite being the most convenient way to authenticate yourself, we do not recommend it as you will probably generate a long-live token without any rotation policy.",
+				MarkdownDescription: "User API key. Could use `CTFD_API_KEY` environment variable instead. Despite being the most convenient way to authenticate yourself, we do not recommend it as you will probably generate a long-live token without any rotation policy. Prefer a token issued by the `ctfd_token` resource, rotated with its `rotate_after`.",
 				Sensitive:           true,
 				Optional:            true,
 			},
@@ -100,6 +152,126 @@ public version control system.
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the user API key, e.g. a secret mounted into the runner. Takes precedence over the credential process, and is not read if `api_key` is set. Could use `CTFD_API_KEY_FILE` environment variable instead.",
+				Optional:            true,
+			},
+			"password_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the administrator or service account password, e.g. a secret mounted into the runner. Takes precedence over the credential process, and is not read if `password` is set. Could use `CTFD_ADMIN_PASSWORD_FILE` environment variable instead.",
+				Optional:            true,
+			},
+			"credential_process": schema.StringAttribute{
+				MarkdownDescription: "Command to run to fetch the credentials, e.g. from a secret manager. It must write on its standard output a JSON object with the `url`, `api_key`, `username` and `password` keys, all optional. The command is not run through a shell. Its values take precedence over environment variables. Could use `CTFD_CREDENTIAL_PROCESS` environment variable instead.",
+				Optional:            true,
+			},
+			"anonymous": schema.BoolAttribute{
+				MarkdownDescription: "Configure the provider without any credentials, as a fresh instance has no administrator yet. Only the `ctfd_setup` resource could then be used. Conflicts with the other credentials.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificate(s) to trust in addition to the system ones, e.g. when CTFd is exposed with a private PKI. Could use `CTFD_CA_CERT_PEM` environment variable instead.",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate to present to CTFd (or its ingress) for mutual TLS. Must be set along `client_key_pem`. Could use `CTFD_CLIENT_CERT_PEM` environment variable instead.",
+				Optional:            true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of the client certificate. Must be set along `client_cert_pem`. Could use `CTFD_CLIENT_KEY_PEM` environment variable instead.",
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable the verification of the CTFd TLS certificate. This should only be used for testing purposes. Could use `CTFD_INSECURE_SKIP_VERIFY` environment variable instead.",
+				Optional:            true,
+			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags added to all challenges (e.g. the event name and edition), in addition to their own `tags`. The resulting set is exposed by the challenges `tags_all` attribute.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"default_topics": schema.SetAttribute{
+				MarkdownDescription: "Topics added to all challenges, in addition to their own `topics`. The resulting set is exposed by the challenges `topics_all` attribute.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers to send along every request, e.g. to go through an identity-aware proxy (`CF-Access-Client-Id`...). They do not override the ones set by the provider (e.g. `Authorization`).",
+				ElementType:         types.StringType,
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"session_cache_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory to cache the CTFd session in when using the username/password configuration, avoiding a `POST /login` on every Terraform run. Sessions are keyed by URL and username, and encrypted with the key defined by the `CTFD_SESSION_CACHE_KEY` environment variable (required). A cached session is validated before being used, else the provider logs in again. Could use `CTFD_SESSION_CACHE_DIR` environment variable instead.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent requests to CTFd, shared among all resources and data sources. Useful as Terraform runs operations in parallel, each of them possibly issuing many requests. If not set, requests are not limited.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of requests to CTFd, shared among all resources and data sources (e.g. `0.5` for one request every two seconds). Each retry counts as a request. If not set, requests are not limited.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum duration of every request to CTFd (e.g. `30s`), including the read of its response. Each retry gets its own timeout. If not set, requests are only bounded by the timeouts of the resources operations.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewDurationValidator(),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Guarantee nothing is mutated in CTFd, e.g. to refresh the state or read data sources against a live event. Plans that would create, update or delete a resource are rejected, and mutating API calls fail. Could use `CTFD_READ_ONLY` environment variable instead.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP(S) or SOCKS5 proxy to reach CTFd through (e.g. `http://proxy.lan:3128`). Could use `CTFD_PROXY_URL` environment variable instead. If not set, the proxy is defined by the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				MarkdownDescription: "Retry policy of the API calls, applied when CTFd ratelimits them (HTTP 429) or is unavailable (HTTP 503). Idempotent calls (e.g. reads) are also retried on gateway errors (HTTP 502 and 504) and on connection resets, but not the others as CTFd may have processed them. A `Retry-After` header returned by CTFd is honored, up to `max_backoff`. If not set, calls are retried up to 5 times with a backoff between 1s and 30s.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of attempts of an API call, including the first one. Set it to 1 to disable retries. Default to 5.",
+						Optional:            true,
+					},
+					"min_backoff": schema.StringAttribute{
+						MarkdownDescription: "Delay before the first retry (e.g. `500ms`), doubled on each new attempt. Default to `1s`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validators.NewDurationValidator(),
+						},
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "Maximum delay between two attempts (e.g. `1m`), including the one requested by a `Retry-After` header. Default to `30s`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validators.NewDurationValidator(),
+						},
+					},
+				},
+			},
+			"wait_for_ready": schema.SingleNestedBlock{
+				MarkdownDescription: "If set, polls the CTFd instance until it answers with a valid page before configuring the API client. Useful when CTFd has just been deployed and is still booting.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: "Maximum duration to wait for CTFd to be ready (e.g. `10m`). Default to `5m`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validators.NewDurationValidator(),
+						},
+					},
+					"interval": schema.StringAttribute{
+						MarkdownDescription: "Duration between two probes (e.g. `10s`), must be positive. Default to `5s`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validators.NewPositiveDurationValidator(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -113,33 +285,232 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	}
 
 	// Check configuration values are known
+	unknowns := []unknownConfig{}
 	if config.URL.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("url"),
-			"Unknown CTFD url.",
-			"The provider cannot guess where to reach the CTFd instance.",
-		)
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("url"),
+			summary: "Unknown CTFD url.",
+			detail:  "The provider cannot guess where to reach the CTFd instance.",
+		})
 	}
 	if config.APIKey.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_key"),
-			"Unknown CTFd API key.",
-			"The provider cannot create the CTFd API client as there is an unknown API key value.",
-		)
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("api_key"),
+			summary: "Unknown CTFd API key.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown API key value.",
+		})
 	}
 	if config.Username.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Unknown CTFd admin or service account username.",
-			"The provider cannot create the CTFd API client as there is an unknown username.",
-		)
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("username"),
+			summary: "Unknown CTFd admin or service account username.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown username.",
+		})
 	}
-	if config.APIKey.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Unknown CTFd admin or service account password.",
-			"The provider cannot create the CTFd API client as there is an unknown password.",
-		)
+	if config.Password.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("password"),
+			summary: "Unknown CTFd admin or service account password.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown password.",
+		})
+	}
+	if config.APIKeyFile.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("api_key_file"),
+			summary: "Unknown CTFd API key file.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown API key file.",
+		})
+	}
+	if config.PasswordFile.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("password_file"),
+			summary: "Unknown CTFd password file.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown password file.",
+		})
+	}
+	if config.CredentialProcess.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("credential_process"),
+			summary: "Unknown credential process.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown credential process.",
+		})
+	}
+	if config.CACertPEM.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("ca_cert_pem"),
+			summary: "Unknown CA certificate.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown CA certificate.",
+		})
+	}
+	if config.ClientCertPEM.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("client_cert_pem"),
+			summary: "Unknown client certificate.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown client certificate.",
+		})
+	}
+	if config.ClientKeyPEM.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("client_key_pem"),
+			summary: "Unknown client key.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown client key.",
+		})
+	}
+	if config.InsecureSkipVerify.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("insecure_skip_verify"),
+			summary: "Unknown insecure skip verify.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown insecure_skip_verify value.",
+		})
+	}
+	if config.Headers.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("headers"),
+			summary: "Unknown HTTP headers.",
+			detail:  "The provider cannot create the CTFd API client as there are unknown HTTP headers.",
+		})
+	}
+	if config.ProxyURL.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("proxy_url"),
+			summary: "Unknown proxy URL.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown proxy URL.",
+		})
+	}
+	if config.SessionCacheDir.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("session_cache_dir"),
+			summary: "Unknown session cache directory.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown session cache directory.",
+		})
+	}
+	if config.MaxConcurrentRequests.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("max_concurrent_requests"),
+			summary: "Unknown maximum concurrent requests.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown max_concurrent_requests value.",
+		})
+	}
+	if config.RequestsPerSecond.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("requests_per_second"),
+			summary: "Unknown requests per second.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown requests_per_second value.",
+		})
+	}
+	if config.RequestTimeout.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("request_timeout"),
+			summary: "Unknown request timeout.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown request_timeout value.",
+		})
+	}
+	if config.DefaultTags.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("default_tags"),
+			summary: "Unknown default tags.",
+			detail:  "The provider cannot define the challenges tags as there are unknown default tags.",
+		})
+	}
+	if config.DefaultTopics.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("default_topics"),
+			summary: "Unknown default topics.",
+			detail:  "The provider cannot define the challenges topics as there are unknown default topics.",
+		})
+	}
+	if config.Anonymous.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("anonymous"),
+			summary: "Unknown anonymous mode.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown anonymous value.",
+		})
+	}
+	if config.ReadOnly.IsUnknown() {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("read_only"),
+			summary: "Unknown read only mode.",
+			detail:  "The provider cannot guarantee nothing is mutated as there is an unknown read_only value.",
+		})
+	}
+	if config.Retry != nil && (config.Retry.MaxAttempts.IsUnknown() || config.Retry.MinBackoff.IsUnknown() || config.Retry.MaxBackoff.IsUnknown()) {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("retry"),
+			summary: "Unknown retry policy.",
+			detail:  "The provider cannot create the CTFd API client as there is an unknown retry policy value.",
+		})
+	}
+	if config.WaitForReady != nil && (config.WaitForReady.Timeout.IsUnknown() || config.WaitForReady.Interval.IsUnknown()) {
+		unknowns = append(unknowns, unknownConfig{
+			path:    path.Root("wait_for_ready"),
+			summary: "Unknown wait for ready configuration.",
+			detail:  "The provider cannot wait for the CTFd instance to be ready as there is an unknown timeout or interval value.",
+		})
+	}
+
+	if len(unknowns) != 0 {
+		// When CTFd is deployed in the same apply, its URL and credentials are only
+		// known once created. If Terraform supports it, defer all resources and data
+		// sources rather than failing the whole plan.
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+			for _, u := range unknowns {
+				resp.Diagnostics.AddAttributeWarning(u.path, u.summary, u.detail+" Resources and data sources are deferred until it is known.")
+			}
+			return
+		}
+
+		for _, u := range unknowns {
+			resp.Diagnostics.AddAttributeError(u.path, u.summary, u.detail+" Consider using deferred actions (e.g. `terraform plan -allow-deferral`), or apply the CTFd infrastructure first.")
+		}
+		return
+	}
+
+	retry := config.Retry.policy(&resp.Diagnostics)
+
+	defaultTags, defaultTopics := []string{}, []string{}
+	if !config.DefaultTags.IsNull() {
+		resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+	}
+	if !config.DefaultTopics.IsNull() {
+		resp.Diagnostics.Append(config.DefaultTopics.ElementsAs(ctx, &defaultTopics, false)...)
+	}
+
+	limits := Limits{}
+	if !config.MaxConcurrentRequests.IsNull() {
+		limits.MaxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+		if limits.MaxConcurrentRequests < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"CTFd provider configuration error",
+				fmt.Sprintf("max_concurrent_requests must be at least 1, got %d.", limits.MaxConcurrentRequests),
+			)
+		}
+	}
+	if !config.RequestsPerSecond.IsNull() {
+		limits.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+		if limits.RequestsPerSecond <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"CTFd provider configuration error",
+				fmt.Sprintf("requests_per_second must be strictly positive, got %g.", limits.RequestsPerSecond),
+			)
+		}
+	}
+
+	var requestTimeout time.Duration
+	if !config.RequestTimeout.IsNull() {
+		// Syntax is already checked by the validator
+		requestTimeout, _ = time.ParseDuration(config.RequestTimeout.ValueString())
+		if requestTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"CTFd provider configuration error",
+				fmt.Sprintf("request_timeout must be strictly positive, got %s.", requestTimeout),
+			)
+		}
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -152,6 +523,68 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	username := os.Getenv("CTFD_ADMIN_USERNAME")
 	password := os.Getenv("CTFD_ADMIN_PASSWORD")
 
+	credentialProcess := os.Getenv("CTFD_CREDENTIAL_PROCESS")
+	if !config.CredentialProcess.IsNull() {
+		credentialProcess = config.CredentialProcess.ValueString()
+	}
+	if credentialProcess != "" {
+		creds, err := RunCredentialProcess(ctx, credentialProcess)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credential_process"),
+				"CTFd provider configuration error",
+				fmt.Sprintf("Failed to fetch credentials from the credential process: %s", err),
+			)
+			return
+		}
+		if creds.URL != "" {
+			url = creds.URL
+		}
+		if creds.APIKey != "" {
+			apiKey = creds.APIKey
+		}
+		if creds.Username != "" {
+			username = creds.Username
+		}
+		if creds.Password != "" {
+			password = creds.Password
+		}
+	}
+
+	apiKeyFile := os.Getenv("CTFD_API_KEY_FILE")
+	if !config.APIKeyFile.IsNull() {
+		apiKeyFile = config.APIKeyFile.ValueString()
+	}
+	// An explicit attribute overrides the file, which is then not read
+	if apiKeyFile != "" && config.APIKey.IsNull() {
+		v, err := readSecretFile(apiKeyFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"CTFd provider configuration error",
+				fmt.Sprintf("Failed to read the API key file: %s", err),
+			)
+			return
+		}
+		apiKey = v
+	}
+	passwordFile := os.Getenv("CTFD_ADMIN_PASSWORD_FILE")
+	if !config.PasswordFile.IsNull() {
+		passwordFile = config.PasswordFile.ValueString()
+	}
+	if passwordFile != "" && config.Password.IsNull() {
+		v, err := readSecretFile(passwordFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password_file"),
+				"CTFd provider configuration error",
+				fmt.Sprintf("Failed to read the password file: %s", err),
+			)
+			return
+		}
+		password = v
+	}
+
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
 	}
@@ -165,10 +598,103 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		password = config.Password.ValueString()
 	}
 
+	tlsOpts := TLSOptions{
+		CACertPEM:     os.Getenv("CTFD_CA_CERT_PEM"),
+		ClientCertPEM: os.Getenv("CTFD_CLIENT_CERT_PEM"),
+		ClientKeyPEM:  os.Getenv("CTFD_CLIENT_KEY_PEM"),
+	}
+	if v, ok := os.LookupEnv("CTFD_INSECURE_SKIP_VERIFY"); ok {
+		insecure, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"CTFd provider configuration error",
+				fmt.Sprintf("Invalid CTFD_INSECURE_SKIP_VERIFY environment variable value: %s", err),
+			)
+			return
+		}
+		tlsOpts.InsecureSkipVerify = insecure
+	}
+	if !config.CACertPEM.IsNull() {
+		tlsOpts.CACertPEM = config.CACertPEM.ValueString()
+	}
+	if !config.ClientCertPEM.IsNull() {
+		tlsOpts.ClientCertPEM = config.ClientCertPEM.ValueString()
+	}
+	if !config.ClientKeyPEM.IsNull() {
+		tlsOpts.ClientKeyPEM = config.ClientKeyPEM.ValueString()
+	}
+	if !config.InsecureSkipVerify.IsNull() {
+		tlsOpts.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	headers := map[string]string{}
+	if !config.Headers.IsNull() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	readOnly := false
+	if v, ok := os.LookupEnv("CTFD_READ_ONLY"); ok {
+		ro, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"CTFd provider configuration error",
+				fmt.Sprintf("Invalid CTFD_READ_ONLY environment variable value: %s", err),
+			)
+			return
+		}
+		readOnly = ro
+	}
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+
+	rawProxyURL := os.Getenv("CTFD_PROXY_URL")
+	if !config.ProxyURL.IsNull() {
+		rawProxyURL = config.ProxyURL.ValueString()
+	}
+	proxyURL, err := ParseProxyURL(rawProxyURL)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"CTFd provider configuration error",
+			fmt.Sprintf("Invalid proxy URL: %s", err),
+		)
+		return
+	}
+
+	var cache *sessionCache
+	cacheDir := os.Getenv("CTFD_SESSION_CACHE_DIR")
+	if !config.SessionCacheDir.IsNull() {
+		cacheDir = config.SessionCacheDir.ValueString()
+	}
+	if cacheDir != "" {
+		cache, err = newSessionCache(cacheDir, os.Getenv("CTFD_SESSION_CACHE_KEY"))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("session_cache_dir"),
+				"CTFd provider configuration error",
+				fmt.Sprintf("Invalid session cache configuration (check the CTFD_SESSION_CACHE_KEY environment variable): %s", err),
+			)
+			return
+		}
+	}
+
 	// Check there is enough content
 	ak := apiKey != ""
 	up := username != "" && password != ""
-	if !ak && !up {
+	// Without any credentials, the instance could only be setup
+	anonymous := config.Anonymous.ValueBool()
+	if anonymous && (ak || username != "" || password != "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("anonymous"),
+			"CTFd provider configuration error",
+			"The provider cannot be anonymous as there are credentials configured.",
+		)
+		return
+	}
+	if !ak && !up && !anonymous {
 		resp.Diagnostics.AddError(
 			"CTFd provider configuration error",
 			"The provider cannot create the CTFd API client as there is an invalid configuration. Expected either an API key, a nonce and session, or a username and password.",
@@ -181,59 +707,174 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	ctx = utils.AddSensitive(ctx, "ctfd_api_key", apiKey)
 	ctx = utils.AddSensitive(ctx, "ctfd_username", username)
 	ctx = utils.AddSensitive(ctx, "ctfd_password", password)
+	ctx = utils.AddSensitive(ctx, "ctfd_client_key_pem", tlsOpts.ClientKeyPEM)
+	ctx = utils.AddSensitive(ctx, "ctfd_headers", headers)
+	if proxyURL != nil {
+		ctx = tflog.SetField(ctx, "ctfd_proxy_url", proxyURL.Redacted())
+	}
 	tflog.Debug(ctx, "Creating CTFd API client")
 
-	nonce, session, err := GetNonceAndSession(ctx, url, WithTracerProvider(p.tracer))
+	tlsConfig, err := tlsOpts.TLSConfig()
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"CTFd error",
-			fmt.Sprintf("Failed to fetch nonce and session: %s", err),
+			"CTFd provider configuration error",
+			fmt.Sprintf("Invalid TLS configuration: %s", err),
 		)
 		return
 	}
+	if tlsOpts.InsecureSkipVerify {
+		tflog.Warn(ctx, "CTFd TLS certificate verification is disabled")
+	}
+	transport := NewTransport(tlsConfig, proxyURL, headers)
+
+	opts := []Option{
+		WithTracerProvider(p.tracer),
+		WithRetryPolicy(retry),
+		WithTransport(transport),
+		WithLimits(limits),
+	}
+	if requestTimeout > 0 {
+		opts = append(opts, WithRequestTimeout(requestTimeout))
+	}
+	if readOnly {
+		opts = append(opts, WithReadOnly())
+	}
 
-	client := NewClient(url, nonce, session, apiKey)
-	if up {
-		// XXX due to the CTFd ratelimiter on rare endpoint
-		if _, ok := os.LookupEnv("TF_ACC"); ok {
-			time.Sleep(5 * time.Second)
+	login := &api.LoginParams{
+		Name:     username,
+		Password: password,
+	}
+
+	var client *Client
+	if anonymous {
+		if config.WaitForReady != nil {
+			timeout, interval := config.WaitForReady.durations()
+			if _, _, err := WaitForReady(ctx, url, timeout, interval, opts...); err != nil {
+				resp.Diagnostics.AddError(
+					"CTFd error",
+					fmt.Sprintf("Failed to fetch nonce and session: %s", err),
+				)
+				return
+			}
 		}
+		client = NewAnonymousClient(url, opts...)
+	}
+	// Reuse the cached session if still valid, to avoid the ratelimited POST /login
+	if client == nil && up && cache != nil {
+		client = cachedClient(ctx, cache, url, login, apiKey, opts...)
+	}
+	if client == nil {
+		var nonce, session string
+		if config.WaitForReady != nil {
+			timeout, interval := config.WaitForReady.durations()
+			nonce, session, err = WaitForReady(ctx, url, timeout, interval, opts...)
+		} else {
+			nonce, session, err = GetNonceAndSession(ctx, url, opts...)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"CTFd error",
+				fmt.Sprintf("Failed to fetch nonce and session: %s", err),
+			)
+			return
+		}
+
+		client = NewClient(url, nonce, session, apiKey, opts...)
+		if up {
+			if err := client.Login(ctx, login, WithTracerProvider(p.tracer)); err != nil {
+				resp.Diagnostics.AddError(
+					"CTFd error",
+					fmt.Sprintf("Failed to login: %s", err),
+				)
+				return
+			}
+
+			if cache != nil {
+				client.cache = cache
+				nonce, session := client.Session()
+				if err := cache.Store(url, username, nonce, session); err != nil {
+					resp.Diagnostics.AddWarning(
+						"CTFd session cache error",
+						fmt.Sprintf("Failed to cache the CTFd session, next runs will login again: %s", err),
+					)
+				}
+			}
+		}
+	}
 
-		if err := client.Login(ctx, &api.LoginParams{
-			Name:     username,
-			Password: password,
-		}, WithTracerProvider(p.tracer)); err != nil {
+	// The identity and capabilities could not be fetched anonymously, nor
+	// are they required to setup the instance
+	var caps *Capabilities
+	if !anonymous {
+		// Fail fast if not an admin, rather than on every resource with partial data
+		identity, err := GetIdentity(ctx, client, WithTracerProvider(p.tracer))
+		if err != nil {
 			resp.Diagnostics.AddError(
 				"CTFd error",
-				fmt.Sprintf("Failed to login: %s", err),
+				fmt.Sprintf("Failed to authenticate, check the credentials: %s", err),
+			)
+			return
+		}
+		if !IsAdmin(identity) {
+			resp.Diagnostics.AddError(
+				"CTFd provider configuration error",
+				fmt.Sprintf("The provider is authenticated as %q (id %d) which is not an admin, while the provider requires admin privileges.", identity.Name, identity.ID),
 			)
 			return
 		}
+		ctx = tflog.SetField(ctx, "ctfd_user_id", identity.ID)
+
+		caps, err = DetectCapabilities(ctx, client, WithTracerProvider(p.tracer))
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"CTFd version detection error",
+				fmt.Sprintf("Failed to detect the CTFd version, assuming it supports all features: %s", err),
+			)
+		} else {
+			ctx = tflog.SetField(ctx, "ctfd_version", caps.Version.String())
+		}
 	}
 
 	d := &Framework{
-		Client: client,
-		Tp:     p.tracer,
+		Client:       client,
+		Tp:           p.tracer,
+		Capabilities: caps,
+
+		DefaultTags:   defaultTags,
+		DefaultTopics: defaultTopics,
+
+		ReadOnly: readOnly,
 	}
 	resp.DataSourceData = d
 	resp.ResourceData = d
 
 	tflog.Info(ctx, "Configure CTFd API client", map[string]any{
-		"success": true,
-		"login":   up,
+		"success":   true,
+		"login":     up,
+		"anonymous": anonymous,
 	})
 }
 
 func (p *CTFdProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAwardResource,
 		NewBracketResource,
 		NewChallengeDynamicResource,
 		NewChallengeStandardResource,
+		NewConfigResource,
+		NewEmailSettingsResource,
+		NewEventScheduleResource,
+		NewFieldResource,
 		NewFileResource,
 		NewFlagResource,
 		NewHintResource,
+		NewNotificationResource,
+		NewPageResource,
+		NewSetupResource,
 		NewSolutionResource,
 		NewTeamResource,
+		NewThemeResource,
+		NewTokenResource,
 		NewUserResource,
 	}
 }
@@ -245,10 +886,31 @@ func (p *CTFdProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewChallengeDynamicDataSource,
 		NewUserDataSource,
 		NewTeamDataSource,
+		NewIdentityDataSource,
+		NewPageDataSource,
+		NewAwardDataSource,
 	}
 }
 
+// unknownConfig describes a provider configuration value that is
+// unknown at plan time.
+type unknownConfig struct {
+	path    path.Path
+	summary string
+	detail  string
+}
+
 type Framework struct {
 	Client *Client
 	Tp     trace.TracerProvider
+
+	// Capabilities of the CTFd instance, nil if they could not be detected.
+	Capabilities *Capabilities
+
+	// DefaultTags and DefaultTopics are merged into those of all challenges.
+	DefaultTags   []string
+	DefaultTopics []string
+
+	// ReadOnly rejects plans that would change resources.
+	ReadOnly bool
 }
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// testConfigure configures the provider with the given attributes, all the
+// other ones being null, and the CTFD_* environment variables unset.
+func testConfigure(t *testing.T, values map[string]tftypes.Value, deferralAllowed bool) *provider.ConfigureResponse {
+	t.Helper()
+
+	for _, kv := range os.Environ() {
+		if k, _, _ := strings.Cut(kv, "="); strings.HasPrefix(k, "CTFD_") {
+			t.Setenv(k, "")
+			_ = os.Unsetenv(k)
+		}
+	}
+
+	ctx := context.Background()
+	p := New("test", noop.NewTracerProvider())()
+
+	var sresp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &sresp)
+	typ := sresp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for k, at := range typ.AttributeTypes {
+		attrs[k] = tftypes.NewValue(at, nil)
+		if v, ok := values[k]; ok {
+			attrs[k] = v
+		}
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: sresp.Schema,
+			Raw:    tftypes.NewValue(typ, attrs),
+		},
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+			DeferralAllowed: deferralAllowed,
+		},
+	}, &resp)
+	return &resp
+}
+
+func TestConfigure_Unknown(t *testing.T) {
+	values := map[string]tftypes.Value{
+		"url":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"api_key": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+
+	t.Run("deferred", func(t *testing.T) {
+		resp := testConfigure(t, values, true)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+		}
+		if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+			t.Errorf("got deferred %v, want %v", resp.Deferred, provider.DeferredReasonProviderConfigUnknown)
+		}
+		// Every unknown value is explained
+		if got := resp.Diagnostics.WarningsCount(); got != 2 {
+			t.Errorf("got %d warnings, want 2", got)
+		}
+		if resp.ResourceData != nil || resp.DataSourceData != nil {
+			t.Error("provider data should not be set when deferred")
+		}
+	})
+
+	t.Run("not-deferred", func(t *testing.T) {
+		resp := testConfigure(t, values, false)
+		if resp.Deferred != nil {
+			t.Errorf("got deferred %v, want nil", resp.Deferred)
+		}
+		if got := resp.Diagnostics.ErrorsCount(); got != 2 {
+			t.Errorf("got %d errors, want 2", got)
+		}
+		for _, d := range resp.Diagnostics.Errors() {
+			if !strings.Contains(d.Detail(), "-allow-deferral") {
+				t.Errorf("error does not suggest deferred actions: %s", d.Detail())
+			}
+		}
+	})
+}
@@ -3,8 +3,10 @@ package provider_test
 import (
 	"context"
 	"log"
+	"os"
 	"testing"
 
+	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -41,3 +43,24 @@ func TestMain(m *testing.M) {
 
 	m.Run()
 }
+
+// testAccClient returns a client of the acceptance tests CTFd instance,
+// to change it out of Terraform.
+func testAccClient(t *testing.T) *provider.Client {
+	t.Helper()
+
+	ctx := context.Background()
+	url := os.Getenv("CTFD_URL")
+	nonce, session, err := provider.GetNonceAndSession(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := provider.NewClient(url, nonce, session, "")
+	if err := client.Login(ctx, &api.LoginParams{
+		Name:     os.Getenv("CTFD_ADMIN_USERNAME"),
+		Password: os.Getenv("CTFD_ADMIN_PASSWORD"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
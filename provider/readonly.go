@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ErrReadOnly is returned by mutating API calls when the client is read-only.
+var ErrReadOnly = errors.New("the CTFd provider is read-only")
+
+// readOnlyTransport rejects all requests that could mutate CTFd.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+var _ http.RoundTripper = (*readOnlyTransport)(nil)
+
+func (rt *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return rt.next.RoundTrip(req)
+	}
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	return nil, fmt.Errorf("%w, refusing %s %s", ErrReadOnly, req.Method, req.URL.Path)
+}
+
+// withReadOnly wraps the transport to reject the requests that could mutate
+// CTFd, if the options make the client read-only.
+func withReadOnly(tp http.RoundTripper, opts ...Option) http.RoundTripper {
+	if !getOptions(opts...).readOnly {
+		return tp
+	}
+	return &readOnlyTransport{
+		next: tp,
+	}
+}
+
+// rejectChanges adds an error if the provider is read-only and the plan
+// would create, update or delete the resource.
+// It is expected to run once the plan is complete, i.e. deferred at the
+// start of ModifyPlan.
+func (fm *Framework) rejectChanges(state tfsdk.State, plan *tfsdk.Plan, diags *diag.Diagnostics, typeName string) {
+	if !fm.ReadOnly {
+		return
+	}
+
+	var action string
+	switch {
+	case state.Raw.IsNull():
+		action = "create"
+	case plan.Raw.IsNull():
+		action = "delete"
+	case !plan.Raw.Equal(state.Raw):
+		action = "update"
+	default:
+		return
+	}
+	diags.AddError(
+		"Read-only provider",
+		fmt.Sprintf("The provider is configured with read_only = true, refusing to %s this %s. Unset it to apply changes.", action, typeName),
+	)
+}
@@ -0,0 +1,90 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ReadOnly(t *testing.T) {
+	const (
+		readOnlyConfig = `
+provider "ctfd" {
+	read_only = true
+}
+`
+		brackets = `
+resource "ctfd_bracket" "juniors" {
+	name        = "Juniors"
+	description = "Bracket for 14-25 years old players."
+	type        = "users"
+}
+`
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create while writable
+			{
+				Config: providerConfig + brackets,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ctfd_bracket.juniors", "id"),
+				),
+			},
+			// Refresh without changes is allowed
+			{
+				Config:   readOnlyConfig + brackets,
+				PlanOnly: true,
+			},
+			// Update is rejected at plan time
+			{
+				Config: readOnlyConfig + `
+resource "ctfd_bracket" "juniors" {
+	name        = "Juniors"
+	description = "Bracket for 14-25 years old players, and their coaches."
+	type        = "users"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`refusing to update this ctfd_bracket`),
+			},
+			// Create is rejected at plan time
+			{
+				Config: readOnlyConfig + brackets + `
+resource "ctfd_bracket" "seniors" {
+	name        = "Seniors"
+	description = "Bracket for >25 years old players."
+	type        = "users"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`refusing to create this ctfd_bracket`),
+			},
+			// Setup is rejected at plan time
+			{
+				Config: readOnlyConfig + brackets + `
+resource "ctfd_setup" "instance" {
+	ctf_name = "24h IUT"
+	name     = "ctfer"
+	email    = "ctfer-io@protonmail.com"
+	password = "ctfer"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`refusing to create this ctfd_setup`),
+			},
+			// Delete is rejected at plan time
+			{
+				Config:      readOnlyConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`refusing to delete this ctfd_bracket`),
+			},
+			// Back to writable, such that the bracket could be destroyed
+			{
+				Config: providerConfig + brackets,
+			},
+		},
+	})
+}
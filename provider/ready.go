@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	defaultReadyTimeout  = 5 * time.Minute
+	defaultReadyInterval = 5 * time.Second
+)
+
+type waitForReadyModel struct {
+	Timeout  types.String `tfsdk:"timeout"`
+	Interval types.String `tfsdk:"interval"`
+}
+
+// durations returns the timeout and interval defined by the model,
+// with defaults for unset values.
+func (m *waitForReadyModel) durations() (timeout, interval time.Duration) {
+	timeout, interval = defaultReadyTimeout, defaultReadyInterval
+	// Syntax is already checked by the validator
+	if !m.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(m.Timeout.ValueString())
+	}
+	if !m.Interval.IsNull() {
+		interval, _ = time.ParseDuration(m.Interval.ValueString())
+	}
+	return
+}
+
+// WaitForReady polls the CTFd instance until it answers with a valid nonce and
+// session, or until the timeout is reached. In this last case, it returns the
+// error of the last probe.
+//
+// Each probe is a single attempt, as polling already covers the retry policy.
+func WaitForReady(ctx context.Context, url string, timeout, interval time.Duration, opts ...Option) (nonce, session string, err error) {
+	ctx, span := StartAPISpan(ctx, getTracer(opts...))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	opts = slices.Concat(opts, []Option{WithRetryPolicy(RetryPolicy{MaxAttempts: 1})})
+	for probe := 1; ; probe++ {
+		nonce, session, err = GetNonceAndSession(ctx, url, opts...)
+		if err == nil {
+			span.SetAttributes(attribute.Int("probes", probe))
+			return
+		}
+		tflog.Debug(ctx, "CTFd is not ready yet", map[string]any{
+			"probe": probe,
+			"error": err.Error(),
+		})
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			span.SetAttributes(attribute.Int("probes", probe))
+			return "", "", fmt.Errorf("CTFd was not ready after %s, last error: %w", timeout, err)
+		case <-timer.C:
+		}
+	}
+}
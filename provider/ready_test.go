@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupServer serves the CTFd setup page once it answered unavailable for
+// the given number of probes.
+func setupServer(t *testing.T, unavailable int32) (*httptest.Server, *atomic.Int32) {
+	var probes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if probes.Add(1) <= unavailable {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "session"})
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = fmt.Fprintf(w, `<script>var csrfNonce = "%s";</script>`, strings.Repeat("a", 64))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &probes
+}
+
+func TestWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	srv, probes := setupServer(t, 2)
+
+	// A retry policy of the caller is not applied to the probes
+	opts := make([]Option, 0, 2)
+	opts = append(opts, WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 10,
+		MinBackoff:  time.Second,
+		MaxBackoff:  time.Second,
+	}))
+	nonce, session, err := WaitForReady(context.Background(), srv.URL, 5*time.Second, 10*time.Millisecond, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce != strings.Repeat("a", 64) || session != "session" {
+		t.Errorf("got (%q, %q)", nonce, session)
+	}
+	if got := probes.Load(); got != 3 {
+		t.Errorf("got %d probes, want 3", got)
+	}
+
+	// The options of the caller are left untouched
+	if extra := opts[:cap(opts)][1]; extra != nil {
+		t.Errorf("options of the caller have been written to: %v", extra)
+	}
+}
+
+func TestWaitForReady_Timeout(t *testing.T) {
+	t.Parallel()
+
+	srv, probes := setupServer(t, 1<<30)
+
+	start := time.Now()
+	_, _, err := WaitForReady(context.Background(), srv.URL, 100*time.Millisecond, 20*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "not ready after 100ms") {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("waited %s", d)
+	}
+	if got := probes.Load(); got < 2 {
+		t.Errorf("got %d probes, want at least 2", got)
+	}
+}
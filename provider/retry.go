@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RetryPolicy defines how API calls are retried when CTFd ratelimits
+// them or when a transient error occurs.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, doubled on each
+	// new attempt.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including the one
+	// requested by CTFd through a Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used when the provider configuration does not
+// define its own retry policy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	MinBackoff:  time.Second,
+	MaxBackoff:  30 * time.Second,
+}
+
+type retryModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff  types.String `tfsdk:"min_backoff"`
+	MaxBackoff  types.String `tfsdk:"max_backoff"`
+}
+
+// policy returns the retry policy defined by the model, with defaults
+// for unset values.
+func (m *retryModel) policy(diags *diag.Diagnostics) RetryPolicy {
+	p := DefaultRetryPolicy
+	if m == nil {
+		return p
+	}
+
+	if !m.MaxAttempts.IsNull() {
+		p.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+		if p.MaxAttempts < 1 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName("max_attempts"),
+				"Invalid retry policy",
+				fmt.Sprintf("max_attempts must be at least 1, got %d.", p.MaxAttempts),
+			)
+		}
+	}
+	if !m.MinBackoff.IsNull() {
+		// Syntax is already checked by the validator
+		p.MinBackoff, _ = time.ParseDuration(m.MinBackoff.ValueString())
+	}
+	if !m.MaxBackoff.IsNull() {
+		p.MaxBackoff, _ = time.ParseDuration(m.MaxBackoff.ValueString())
+	}
+	if p.MinBackoff > p.MaxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("min_backoff"),
+			"Invalid retry policy",
+			fmt.Sprintf("min_backoff (%s) must not be greater than max_backoff (%s).", p.MinBackoff, p.MaxBackoff),
+		)
+	}
+	return p
+}
+
+// retryTransport replays requests that failed due to CTFd ratelimiting
+// them or to transient errors, following a RetryPolicy.
+// Non-idempotent requests are only replayed once CTFd rejected them, as
+// it may have processed them otherwise (e.g. on a gateway timeout).
+// Each attempt is recorded as an event of the current span.
+type retryTransport struct {
+	next   http.RoundTripper
+	policy RetryPolicy
+}
+
+var _ http.RoundTripper = (*retryTransport)(nil)
+
+func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	span := trace.SpanFromContext(ctx)
+
+	req, err := replayable(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		areq := req
+		if attempt > 1 {
+			areq, err = replay(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := rt.next.RoundTrip(areq)
+
+		attrs := []attribute.KeyValue{
+			attribute.Int("attempt", attempt),
+		}
+		if res != nil {
+			attrs = append(attrs, attribute.Int("http.response.status_code", res.StatusCode))
+		}
+		if err != nil {
+			attrs = append(attrs, attribute.String("error", err.Error()))
+		}
+		span.AddEvent("attempt", trace.WithAttributes(attrs...))
+
+		if attempt >= rt.policy.MaxAttempts || !isRetryable(req.Method, res, err) {
+			return res, err
+		}
+
+		// Don't wait for an attempt that could not happen in time
+		wait := rt.backoff(attempt, res)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return res, err
+		}
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+func (rt *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	d := rt.policy.MinBackoff << (attempt - 1)
+	if d <= 0 || d > rt.policy.MaxBackoff {
+		d = rt.policy.MaxBackoff
+	}
+	// Add jitter to avoid parallel operations retrying all at once
+	if d > 0 {
+		d = d/2 + rand.N(d/2+1)
+	}
+
+	if res != nil {
+		if ra, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(ra, rt.policy.MaxBackoff)
+		}
+	}
+	return d
+}
+
+func isRetryable(method string, res *http.Response, err error) bool {
+	if err != nil {
+		// Connection reset or closed by CTFd (or its reverse proxy), after
+		// the request may have been processed
+		return isIdempotent(method) && (errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF))
+	}
+	switch res.StatusCode {
+	// The request has been rejected before being processed
+	case http.StatusTooManyRequests,
+		http.StatusServiceUnavailable:
+		return true
+	// The request may have been processed, e.g. a proxy timed out
+	case http.StatusBadGateway,
+		http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// isIdempotent returns whether replaying a request of this method has the
+// same effect as issuing it once (RFC 9110 section 9.2.2).
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter supports both forms of the Retry-After header,
+// i.e. a delay in seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// replayable makes sure the body of the request could be replayed.
+func replayable(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return req, nil
+	}
+	b, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return req, nil
+}
+
+// replay returns a copy of a replayable request, with a fresh body.
+func replay(req *http.Request) (*http.Request, error) {
+	areq := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		areq.Body = body
+	}
+	return areq, nil
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  10 * time.Millisecond,
+}
+
+// statusServer answers the statuses in order, then 200, and counts the
+// requests it received.
+func statusServer(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if n <= len(statuses) {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		_, _ = io.Copy(w, r.Body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func doRetry(t *testing.T, policy RetryPolicy, next http.RoundTripper, method, url string) (*http.Response, error) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &retryTransport{
+		next:   next,
+		policy: policy,
+	}
+	return rt.RoundTrip(req)
+}
+
+func TestRetryTransport_Statuses(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method     string
+		statuses   []int
+		wantStatus int
+		wantCalls  int32
+	}{
+		"get-ratelimited": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusTooManyRequests},
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+		},
+		"get-gateway-timeout": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusBadGateway, http.StatusGatewayTimeout},
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		"get-exhausted": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCalls:  3,
+		},
+		"get-not-found": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusNotFound},
+			wantStatus: http.StatusNotFound,
+			wantCalls:  1,
+		},
+		"post-ratelimited": {
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		"post-gateway-timeout": {
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusGatewayTimeout},
+			wantStatus: http.StatusGatewayTimeout,
+			wantCalls:  1,
+		},
+		"patch-bad-gateway": {
+			method:     http.MethodPatch,
+			statuses:   []int{http.StatusBadGateway},
+			wantStatus: http.StatusBadGateway,
+			wantCalls:  1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, calls := statusServer(t, nil, tt.statuses...)
+			res, err := doRetry(t, testRetryPolicy, http.DefaultTransport, tt.method, srv.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status: got %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("calls: got %d, want %d", got, tt.wantCalls)
+			}
+			// The body is replayed on every attempt
+			if res.StatusCode == http.StatusOK {
+				b, _ := io.ReadAll(res.Body)
+				if string(b) != "body" {
+					t.Errorf("body: got %q, want %q", b, "body")
+				}
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransport_ConnectionReset(t *testing.T) {
+	t.Parallel()
+
+	for method, wantCalls := range map[string]int{
+		http.MethodGet:    3,
+		http.MethodDelete: 3,
+		http.MethodPost:   1,
+	} {
+		t.Run(method, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			next := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				calls++
+				return nil, io.EOF
+			})
+			if _, err := doRetry(t, testRetryPolicy, next, method, "http://ctfd.invalid"); err == nil {
+				t.Fatal("expected an error")
+			}
+			if calls != wantCalls {
+				t.Errorf("calls: got %d, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	t.Parallel()
+
+	rt := &retryTransport{
+		policy: RetryPolicy{
+			MaxAttempts: 10,
+			MinBackoff:  100 * time.Millisecond,
+			MaxBackoff:  time.Second,
+		},
+	}
+	for attempt, want := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		5: time.Second,
+		8: time.Second,
+	} {
+		// Jitter draws the delay in the upper half
+		got := rt.backoff(attempt, nil)
+		if got < want/2 || got > want {
+			t.Errorf("attempt %d: got %s, want in [%s, %s]", attempt, got, want/2, want)
+		}
+	}
+}
+
+func TestRetryTransport_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	rt := &retryTransport{
+		policy: RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  5 * time.Second,
+		},
+	}
+	for header, want := range map[string]time.Duration{
+		"2":    2 * time.Second,
+		"0":    0,
+		"3600": 5 * time.Second, // capped by MaxBackoff
+		time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat): 0,
+		time.Now().Add(time.Hour).UTC().Format(http.TimeFormat):  5 * time.Second,
+	} {
+		res := &http.Response{
+			Header: http.Header{"Retry-After": []string{header}},
+		}
+		if got := rt.backoff(1, res); got != want {
+			t.Errorf("Retry-After %q: got %s, want %s", header, got, want)
+		}
+	}
+}
+
+func TestRetryTransport_RetryAfterDeadline(t *testing.T) {
+	t.Parallel()
+
+	srv, calls := statusServer(t, http.Header{"Retry-After": []string{"10"}}, http.StatusTooManyRequests)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	rt := &retryTransport{
+		next: http.DefaultTransport,
+		policy: RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Minute,
+		},
+	}
+
+	// Gives up immediately, rather than waiting past the deadline
+	start := time.Now()
+	res, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status: got %d, want %d", res.StatusCode, http.StatusTooManyRequests)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls: got %d, want 1", calls.Load())
+	}
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Errorf("waited %s", d)
+	}
+}
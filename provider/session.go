@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// nonceRegex matches the CSRF nonce CTFd embeds in its HTML pages,
+// as github.com/ctfer-io/go-ctfd does.
+var nonceRegex = regexp.MustCompile(`([0-9a-f]{64})`)
+
+// sessionRecorder records the session cookie and the nonce returned by CTFd,
+// as the underlying client does not expose them.
+type sessionRecorder struct {
+	next    http.RoundTripper
+	nonce   string
+	session string
+}
+
+var _ http.RoundTripper = (*sessionRecorder)(nil)
+
+func (rt *sessionRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cookie := range res.Cookies() {
+		if cookie.Name == "session" {
+			rt.session = cookie.Value
+		}
+	}
+	if strings.HasPrefix(res.Header.Get("Content-Type"), "text/html") {
+		b, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(b))
+
+		if match := nonceRegex.Find(b); match != nil {
+			rt.nonce = string(match)
+		}
+	}
+	return res, nil
+}
+
+// reloginTransport renews the session of a Client once CTFd rejects it,
+// then replays the request with the new one.
+type reloginTransport struct {
+	next http.RoundTripper
+	cli  *Client
+}
+
+var _ http.RoundTripper = (*reloginTransport)(nil)
+
+func (rt *reloginTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, err := replayable(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rt.cli.mu.RLock()
+	nonce, session, gen := rt.cli.nonce, rt.cli.session, rt.cli.gen
+	rt.cli.mu.RUnlock()
+
+	res, err := rt.next.RoundTrip(withSession(req, nonce, session))
+	if err != nil || !isSessionExpired(res) {
+		return res, err
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+
+	ctx := req.Context()
+	tflog.Debug(ctx, "CTFd session expired, logging in again")
+	trace.SpanFromContext(ctx).AddEvent("relogin", trace.WithAttributes(
+		attribute.Int("http.response.status_code", res.StatusCode),
+	))
+	if err := rt.cli.relogin(ctx, gen); err != nil {
+		return nil, errors.Join(errors.New("CTFd session expired and login failed"), err)
+	}
+
+	rt.cli.mu.RLock()
+	nonce, session = rt.cli.nonce, rt.cli.session
+	rt.cli.mu.RUnlock()
+
+	areq, err := replay(req)
+	if err != nil {
+		return nil, err
+	}
+	return rt.next.RoundTrip(withSession(areq, nonce, session))
+}
+
+// relogin fetches a new nonce and session then logs in again, unless
+// the session has already been renewed since generation gen.
+// This avoids parallel operations to all login at once.
+func (cli *Client) relogin(ctx context.Context, gen uint64) error {
+	cli.mu.Lock()
+	defer cli.mu.Unlock()
+
+	if cli.gen != gen {
+		return nil
+	}
+
+	nonce, session, err := GetNonceAndSession(ctx, cli.url, cli.opts...)
+	if err != nil {
+		return err
+	}
+
+	ctx, span := StartAPISpan(ctx, getTracer(cli.opts...))
+	defer span.End()
+
+	rec := &sessionRecorder{
+		next: apiTransport(cli.opts...),
+	}
+	sub := api.NewClient(cli.url, nonce, session, "")
+	if err := sub.Login(cli.login, api.WithContext(ctx), api.WithTransport(rec)); err != nil {
+		return err
+	}
+
+	cli.nonce, cli.session = nonce, session
+	if rec.nonce != "" {
+		cli.nonce = rec.nonce
+	}
+	if rec.session != "" {
+		cli.session = rec.session
+	}
+	cli.gen++
+
+	// Next runs would otherwise load the expired session, then login again
+	if cli.cache != nil {
+		if err := cli.cache.Store(cli.url, cli.login.Name, cli.nonce, cli.session); err != nil {
+			tflog.Warn(ctx, "Failed to cache the renewed CTFd session", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+	return nil
+}
+
+// withSession returns a copy of the request authenticated with the given
+// nonce and session, rather than the ones of the underlying client.
+func withSession(req *http.Request, nonce, session string) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("CSRF-Token", nonce)
+	req.Header.Set("Cookie", "session="+session)
+	return req
+}
+
+// forbiddenMessage is part of the description of the HTTP 403 CTFd aborts
+// with when the session of a request is not valid anymore: its admins_only
+// and authed_only decorators reject the then anonymous API call, and its CSRF
+// check rejects the nonce of the former session. The API endpoints rather
+// return their own errors.
+const forbiddenMessage = "permission to access the requested resource"
+
+// isSessionExpired returns whether CTFd rejected the session of the request,
+// either by redirecting to the login page or by aborting with forbiddenMessage.
+// The body of the response is kept readable.
+func isSessionExpired(res *http.Response) bool {
+	switch {
+	case res.StatusCode == http.StatusForbidden:
+		b, err := io.ReadAll(io.LimitReader(res.Body, 4096))
+		res.Body = struct {
+			io.Reader
+			io.Closer
+		}{
+			Reader: io.MultiReader(bytes.NewReader(b), res.Body),
+			Closer: res.Body,
+		}
+		return err == nil && bytes.Contains(b, []byte(forbiddenMessage))
+
+	case res.StatusCode >= 300 && res.StatusCode < 400:
+		loc, err := res.Location()
+		return err == nil && strings.HasSuffix(loc.Path, "/login")
+	}
+	return false
+}
+
+// sessionCache stores CTFd sessions on disk, encrypted, to avoid
+// logging in on every Terraform run.
+type sessionCache struct {
+	dir  string
+	aead cipher.AEAD
+}
+
+type cachedSession struct {
+	Nonce   string `json:"nonce"`
+	Session string `json:"session"`
+}
+
+// newSessionCache creates a session cache in dir, encrypted with AES-GCM
+// using a key derived from the given secret.
+func newSessionCache(dir, secret string) (*sessionCache, error) {
+	if secret == "" {
+		return nil, errors.New("an encryption key is required")
+	}
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionCache{
+		dir:  dir,
+		aead: aead,
+	}, nil
+}
+
+// file returns the file a session is cached in, keyed by the CTFd URL
+// and the username.
+func (c *sessionCache) file(url, username string) string {
+	h := sha256.Sum256([]byte(url + "\x00" + username))
+	return filepath.Join(c.dir, hex.EncodeToString(h[:])+".session")
+}
+
+// Load returns the cached nonce and session, if any.
+func (c *sessionCache) Load(url, username string) (nonce, session string, err error) {
+	b, err := os.ReadFile(c.file(url, username))
+	if err != nil {
+		return "", "", err
+	}
+	ns := c.aead.NonceSize()
+	if len(b) < ns {
+		return "", "", errors.New("cached session is corrupted")
+	}
+	plain, err := c.aead.Open(nil, b[:ns], b[ns:], []byte(url))
+	if err != nil {
+		return "", "", err
+	}
+	var cs cachedSession
+	if err := json.Unmarshal(plain, &cs); err != nil {
+		return "", "", err
+	}
+	return cs.Nonce, cs.Session, nil
+}
+
+// Store caches the nonce and session.
+func (c *sessionCache) Store(url, username, nonce, session string) error {
+	plain, err := json.Marshal(cachedSession{
+		Nonce:   nonce,
+		Session: session,
+	})
+	if err != nil {
+		return err
+	}
+	n := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(n); err != nil {
+		return err
+	}
+	b := c.aead.Seal(n, n, plain, []byte(url))
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(c.dir, ".session-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.file(url, username))
+}
+
+// cachedClient returns a client using the cached session, if any and still
+// valid. The validation is a cheap authenticated API call.
+func cachedClient(ctx context.Context, cache *sessionCache, url string, login *api.LoginParams, apiKey string, opts ...Option) *Client {
+	nonce, session, err := cache.Load(url, login.Name)
+	if err != nil {
+		tflog.Debug(ctx, "No usable cached CTFd session", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	client := NewClient(url, nonce, session, apiKey, opts...)
+	if _, _, err := client.GetUsersMe(ctx, opts...); err != nil {
+		tflog.Debug(ctx, "Cached CTFd session has been rejected", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+	// Login again with the same credentials once it expires
+	client.login = login
+	client.cache = cache
+	return client
+}
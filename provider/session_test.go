@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ctfer-io/go-ctfd/api"
+)
+
+func TestIsSessionExpired(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		location string
+		body     string
+		want     bool
+	}{
+		"ok": {
+			status: http.StatusOK,
+			body:   `{"success": true}`,
+		},
+		"redirect-login": {
+			status:   http.StatusFound,
+			location: "/login?next=%2Fapi%2Fv1%2Fchallenges",
+			want:     true,
+		},
+		"redirect-other": {
+			status:   http.StatusFound,
+			location: "/setup",
+		},
+		"forbidden-api": {
+			status: http.StatusForbidden,
+			body:   `{"message": "You don't have the permission to access the requested resource. It is either read-protected or not readable by the server."}`,
+			want:   true,
+		},
+		"forbidden-csrf": {
+			status: http.StatusForbidden,
+			body:   `<h1>Forbidden</h1><p>You don&#39;t have the permission to access the requested resource. It is either read-protected or not readable by the server.</p>`,
+			want:   true,
+		},
+		"forbidden-other": {
+			status: http.StatusForbidden,
+			body:   `{"success": false, "errors": {"": ["You are not allowed to do this"]}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &http.Response{
+				StatusCode: tt.status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+				Request:    &http.Request{},
+			}
+			if tt.location != "" {
+				res.Header.Set("Location", tt.location)
+			}
+
+			if got := isSessionExpired(res); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+			// The body is still readable
+			b, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.body {
+				t.Errorf("body: got %q, want %q", b, tt.body)
+			}
+		})
+	}
+}
+
+func TestSessionCache_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cache, err := newSessionCache(dir, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cache.Store("http://ctfd", "ctfer", "nonce", "session"); err != nil {
+		t.Fatalf("store: %s", err)
+	}
+	nonce, session, err := cache.Load("http://ctfd", "ctfer")
+	if err != nil {
+		t.Fatalf("load: %s", err)
+	}
+	if nonce != "nonce" || session != "session" {
+		t.Errorf("got (%q, %q), want (%q, %q)", nonce, session, "nonce", "session")
+	}
+
+	// The session is not stored in cleartext
+	b, err := os.ReadFile(cache.file("http://ctfd", "ctfer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) == 0 || strings.Contains(string(b), "nonce") || strings.Contains(string(b), "session") {
+		t.Errorf("cached session is not encrypted: %q", b)
+	}
+
+	// Another secret cannot decrypt it
+	other, err := newSessionCache(dir, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := other.Load("http://ctfd", "ctfer"); err == nil {
+		t.Error("expected an error when loading with another secret")
+	}
+
+	// Another user or instance has no session
+	if _, _, err := cache.Load("http://ctfd", "other"); err == nil {
+		t.Error("expected an error when loading the session of another user")
+	}
+	if _, _, err := cache.Load("http://other", "ctfer"); err == nil {
+		t.Error("expected an error when loading the session of another instance")
+	}
+}
+
+func TestSessionCache_Corrupted(t *testing.T) {
+	t.Parallel()
+
+	cache, err := newSessionCache(t.TempDir(), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Store("http://ctfd", "ctfer", "nonce", "session"); err != nil {
+		t.Fatal(err)
+	}
+
+	file := cache.file("http://ctfd", "ctfer")
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[len(b)-1] ^= 0xff
+	if err := os.WriteFile(file, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := cache.Load("http://ctfd", "ctfer"); err == nil {
+		t.Error("expected an error when loading a tampered session")
+	}
+
+	if err := os.WriteFile(file, []byte("short"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := cache.Load("http://ctfd", "ctfer"); err == nil {
+		t.Error("expected an error when loading a truncated session")
+	}
+}
+
+func TestSessionCache_NoSecret(t *testing.T) {
+	t.Parallel()
+
+	if _, err := newSessionCache(t.TempDir(), ""); err == nil {
+		t.Error("expected an error without an encryption key")
+	}
+}
+
+func TestRelogin_StoresSession(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/setup":
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "fresh"})
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = fmt.Fprintf(w, `<script>var csrfNonce = "%s";</script>`, strings.Repeat("a", 64))
+		case "/login":
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "renewed"})
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = fmt.Fprintf(w, `<script>var csrfNonce = "%s";</script>`, strings.Repeat("b", 64))
+		default:
+			if c, err := r.Cookie("session"); err != nil || c.Value != "renewed" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("You don't have the " + forbiddenMessage))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"success":true,"data":{"id":1,"name":"ctfer"}}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cache, err := newSessionCache(t.TempDir(), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(srv.URL, strings.Repeat("c", 64), "expired", "")
+	client.login = &api.LoginParams{Name: "ctfer", Password: "ctfer"}
+	client.cache = cache
+
+	if _, _, err := client.GetUsersMe(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The renewed session is cached for the next runs
+	nonce, session, err := cache.Load(srv.URL, "ctfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce != strings.Repeat("b", 64) || session != "renewed" {
+		t.Errorf("got (%q, %q), want the renewed session", nonce, session)
+	}
+}
@@ -0,0 +1,532 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = (*setupResource)(nil)
+	_ resource.ResourceWithConfigure  = (*setupResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*setupResource)(nil)
+)
+
+const (
+	// setupID is the identifier of the setup, as there is only one per
+	// CTFd instance.
+	setupID = "setup"
+
+	// setupAPIKeyIDKey is the private state key of the API key identifier,
+	// used to revoke it.
+	setupAPIKeyIDKey = "api_key_id"
+
+	// apiKeyExpirationLayout is the layout of the API keys expiration date.
+	apiKeyExpirationLayout = "2006-01-02"
+)
+
+func NewSetupResource() resource.Resource {
+	return &setupResource{}
+}
+
+type setupResource struct {
+	fm *Framework
+}
+
+type setupResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	CTFName          types.String   `tfsdk:"ctf_name"`
+	CTFDescription   types.String   `tfsdk:"ctf_description"`
+	UserMode         types.String   `tfsdk:"user_mode"`
+	TeamSize         types.Int64    `tfsdk:"team_size"`
+	Name             types.String   `tfsdk:"name"`
+	Email            types.String   `tfsdk:"email"`
+	Password         types.String   `tfsdk:"password"`
+	Theme            types.String   `tfsdk:"theme"`
+	Start            types.String   `tfsdk:"start"`
+	End              types.String   `tfsdk:"end"`
+	APIKeyExpiration types.String   `tfsdk:"api_key_expiration"`
+	APIKey           types.String   `tfsdk:"api_key"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// setupValues are the values sent to CTFd during the setup, which could
+// not be changed afterwards through this resource.
+func (data *setupResourceModel) setupValues() []attr.Value {
+	return []attr.Value{
+		data.CTFName,
+		data.CTFDescription,
+		data.UserMode,
+		data.TeamSize,
+		data.Name,
+		data.Email,
+		data.Password,
+		data.Theme,
+		data.Start,
+		data.End,
+	}
+}
+
+func (r *setupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setup"
+}
+
+func (r *setupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The setup of a fresh CTFd instance, i.e. its name, user mode and administrator, then issues an API key of this administrator to configure the provider with.\n\nIt could be used with a provider configured with only the `url` and `anonymous = true`, as the instance has no administrator yet. If the instance has already been set up, the setup is skipped with a warning and no API key is issued.\n\nThe setup only happens once: later changes of its attributes are not applied to CTFd, use the `ctfd_config` and `ctfd_user` resources instead. Destroying it only revokes the API key, the instance remains set up.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the setup, always `" + setupID + "`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ctf_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Capture The Flag event.",
+				Required:            true,
+			},
+			"ctf_description": schema.StringAttribute{
+				MarkdownDescription: "Description of the Capture The Flag event.",
+				Optional:            true,
+			},
+			"user_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether participants play on their own or in teams, either \"users\" or \"teams\".",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("users"),
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						types.StringValue("users"),
+						types.StringValue("teams"),
+					}),
+				},
+			},
+			"team_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of members per team, when `user_mode` is \"teams\". If not set, teams are unlimited.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the administrator.",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the administrator.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the administrator.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "Theme of the CTFd instance. Default to `core`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("core"),
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "When the event starts, as an RFC3339 date-time (e.g. `2026-10-17T09:00:00+02:00`), or without offset in UTC. If not set, the event is started.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewDateTimeValidator(),
+				},
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "When the event ends, as an RFC3339 date-time, or without offset in UTC. Must be after `start`. If not set, the event never ends.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewDateTimeValidator(),
+				},
+			},
+			"api_key_expiration": schema.StringAttribute{
+				MarkdownDescription: "Expiration date of the API key (e.g. `2026-12-31`). Changing it issues a new API key. If not set, CTFd defaults to 30 days after its issuance.",
+				Optional:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key of the administrator, to configure the provider with. Null if the instance had already been set up.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *setupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *setupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_setup")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data setupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.APIKeyExpiration.IsNull() && !data.APIKeyExpiration.IsUnknown() {
+		if _, err := time.Parse(apiKeyExpirationLayout, data.APIKeyExpiration.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_expiration"),
+				"Invalid API key expiration",
+				fmt.Sprintf("The API key expiration must be a date (e.g. 2026-12-31), got %q.", data.APIKeyExpiration.ValueString()),
+			)
+		}
+	}
+	start, end := parseSetupDate(data.Start), parseSetupDate(data.End)
+	if start != nil && end != nil && !start.Before(*end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Invalid setup",
+			fmt.Sprintf("The event must end after it starts, got start %s and end %s.", data.Start.ValueString(), data.End.ValueString()),
+		)
+	}
+
+	// Nothing else to check on creation.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state setupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValues, stateValues := data.setupValues(), state.setupValues()
+	for i := range planValues {
+		if !planValues[i].Equal(stateValues[i]) {
+			resp.Diagnostics.AddWarning(
+				"CTFd setup changes are not applied",
+				"The CTFd instance has already been set up, changes of the setup attributes are only saved in state. Use the ctfd_config and ctfd_user resources to change them in CTFd.",
+			)
+			break
+		}
+	}
+	if !data.APIKeyExpiration.Equal(state.APIKeyExpiration) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("api_key"), types.StringUnknown())...)
+	}
+}
+
+func (r *setupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data setupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	data.ID = types.StringValue(setupID)
+	data.APIKey = types.StringNull()
+
+	isSetup, err := r.fm.Client.IsSetup(ctx, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to check whether CTFd is set up, got error: %s", err),
+		)
+		return
+	}
+	if isSetup {
+		resp.Diagnostics.AddWarning(
+			"CTFd already set up",
+			"The CTFd instance has already been set up, so the setup has been skipped and no API key has been issued.",
+		)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	params := &api.SetupParams{
+		CTFName:        data.CTFName.ValueString(),
+		CTFDescription: data.CTFDescription.ValueString(),
+		UserMode:       data.UserMode.ValueString(),
+		// CTFd rejects empty visibilities, so use the defaults of its setup form
+		ChallengeVisibility:    "private",
+		AccountVisibility:      "public",
+		ScoreVisibility:        "public",
+		RegistrationVisibility: "public",
+		Name:                   data.Name.ValueString(),
+		Email:                  data.Email.ValueString(),
+		Password:               data.Password.ValueString(),
+		CTFTheme:               data.Theme.ValueString(),
+		TeamSize:               utils.ToInt(data.TeamSize),
+	}
+	if t := parseSetupDate(data.Start); t != nil {
+		params.Start = strconv.FormatInt(t.Unix(), 10)
+	}
+	if t := parseSetupDate(data.End); t != nil {
+		params.End = strconv.FormatInt(t.Unix(), 10)
+	}
+	if err := r.fm.Client.Setup(ctx, params, WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to setup CTFd, got error: %s", err),
+		)
+		return
+	}
+
+	// CTFd serves the setup form again if it rejected the values
+	isSetup, err = r.fm.Client.IsSetup(ctx, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to check whether CTFd is set up, got error: %s", err),
+		)
+		return
+	}
+	if !isSetup {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			"CTFd did not complete the setup, it could have rejected the values (e.g. an invalid email, or a too long name or password).",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "set up CTFd")
+
+	// The instance is set up, so save it in state even if the API key could not be issued
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.issueAPIKey(ctx, &data, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *setupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data setupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	isSetup, err := r.fm.Client.IsSetup(ctx, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to check whether CTFd is set up, got error: %s", err),
+		)
+		return
+	}
+	// The instance has been reset, so it has to be set up again
+	if !isSetup {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Nothing else to read, as the setup values could not be read back
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *setupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data setupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only the API key could change, other changes are only saved in state
+	if data.APIKey.IsUnknown() {
+		var state setupResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.revokeAPIKey(ctx, state.APIKey, req.Private)...)
+		resp.Diagnostics.Append(r.issueAPIKey(ctx, &data, resp.Private)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *setupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data setupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// A setup could not be undone, only revoke the API key
+	resp.Diagnostics.Append(r.revokeAPIKey(ctx, data.APIKey, req.Private)...)
+}
+
+// issueAPIKey logins as the administrator to issue its API key, and keeps
+// its identifier in private state to revoke it later.
+func (r *setupResource) issueAPIKey(ctx context.Context, data *setupResourceModel, private privateStateSetter) (diags diag.Diagnostics) {
+	admin, err := r.fm.Client.NewSession(ctx, &api.LoginParams{
+		Name:     data.Name.ValueString(),
+		Password: data.Password.ValueString(),
+	}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to login as the CTFd administrator, got error: %s", err),
+		)
+		return
+	}
+	token, _, err := admin.PostTokens(ctx, &api.PostTokensParams{
+		Description: "Terraform ctfd_setup",
+		Expiration:  data.APIKeyExpiration.ValueString(),
+	}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to issue an API key, got error: %s", err),
+		)
+		return
+	}
+	if token.Value == nil {
+		diags.AddError(
+			"Client Error",
+			"Unable to issue an API key, CTFd did not return its value.",
+		)
+		return
+	}
+
+	data.APIKey = types.StringValue(*token.Value)
+	b, _ := json.Marshal(strconv.Itoa(token.ID))
+	diags.Append(private.SetKey(ctx, setupAPIKeyIDKey, b)...)
+	return
+}
+
+// revokeAPIKey revokes the API key issued during the setup, if any.
+// It only warns on failure, as the instance could have been destroyed.
+func (r *setupResource) revokeAPIKey(ctx context.Context, apiKey types.String, private privateStateGetter) (diags diag.Diagnostics) {
+	if apiKey.IsNull() || apiKey.IsUnknown() {
+		return
+	}
+	b, getDiags := private.GetKey(ctx, setupAPIKeyIDKey)
+	diags.Append(getDiags...)
+	if diags.HasError() || len(b) == 0 {
+		return
+	}
+	var id string
+	if err := json.Unmarshal(b, &id); err != nil {
+		diags.AddError(
+			"Invalid private state",
+			fmt.Sprintf("Unable to decode the API key identifier, got error: %s", err),
+		)
+		return
+	}
+
+	if _, err := r.fm.Client.WithAPIKey(apiKey.ValueString()).DeleteToken(ctx, id, WithTracerProvider(r.fm.Tp)); err != nil {
+		diags.AddWarning(
+			"Client Error",
+			fmt.Sprintf("Unable to revoke the API key %s, got error: %s", id, err),
+		)
+	}
+	return
+}
+
+// parseSetupDate returns the date-time of a known value, in UTC if it
+// has no offset.
+func parseSetupDate(v types.String) *time.Time {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	t, err := validators.ParseDateTime(v.ValueString(), time.UTC)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
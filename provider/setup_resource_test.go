@@ -0,0 +1,49 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Setup_AlreadySetUp(t *testing.T) {
+	// The acceptance tests instance is already set up, so the setup is
+	// skipped and no API key is issued.
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_setup" "instance" {
+	ctf_name = "24h IUT"
+	name     = "ctfer"
+	email    = "ctfer-io@protonmail.com"
+	password = "ctfer"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_setup.instance", "id", "setup"),
+					resource.TestCheckResourceAttr("ctfd_setup.instance", "user_mode", "users"),
+					resource.TestCheckNoResourceAttr("ctfd_setup.instance", "api_key"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_setup" "instance" {
+	ctf_name = "24h IUT 2026"
+	name     = "ctfer"
+	email    = "ctfer-io@protonmail.com"
+	password = "ctfer"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_setup.instance", "ctf_name", "24h IUT 2026"),
+					resource.TestCheckNoResourceAttr("ctfd_setup.instance", "api_key"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = (*solutionResource)(nil)
 	_ resource.ResourceWithConfigure   = (*solutionResource)(nil)
 	_ resource.ResourceWithImportState = (*solutionResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*solutionResource)(nil)
 )
 
 func NewSolutionResource() resource.Resource {
@@ -39,6 +41,8 @@ type solutionResourceModel struct {
 	ChallengeID types.String `tfsdk:"challenge_id"`
 	Content     types.String `tfsdk:"content"`
 	State       types.String `tfsdk:"state"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *solutionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,6 +82,9 @@ func (r *solutionResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -99,6 +106,21 @@ func (r *solutionResource) Configure(ctx context.Context, req resource.Configure
 	r.fm = fm
 }
 
+func (r *solutionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_solution")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.fm.Capabilities.Require(&resp.Diagnostics, path.Empty(), "`ctfd_solution`", CapabilitySolutions)
+}
+
 func (r *solutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
@@ -109,6 +131,14 @@ func (r *solutionResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create solution
 	res, _, err := r.fm.Client.PostSolutions(ctx, &api.PostSolutionsParams{
 		ChallengeID: utils.Atoi(data.ChallengeID.ValueString()),
@@ -144,6 +174,14 @@ func (r *solutionResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Retrieve solution
 	res, _, err := r.fm.Client.GetSolutions(ctx, data.ID.ValueString(), nil, WithTracerProvider(r.fm.Tp))
 	if err != nil {
@@ -175,6 +213,14 @@ func (r *solutionResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update solution
 	if _, _, err := r.fm.Client.PatchSolutions(ctx, data.ID.ValueString(), &api.PatchSolutionsParams{
 		Content: data.Content.ValueString(),
@@ -203,6 +249,14 @@ func (r *solutionResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteSolutions(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete solution of challenge %s, got error: %s", data.ChallengeID.ValueString(), err))
 		return
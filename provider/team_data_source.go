@@ -89,6 +89,15 @@ func (data *teamDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							MarkdownDescription: "Member who is captain of the team. Must be part of the members too. Note it could cause a fatal error in case of resource import with an inconsistent CTFd configuration i.e. if a team has no captain yet (should not be possible).",
 							Computed:            true,
 						},
+						"bracket_id": schema.StringAttribute{
+							MarkdownDescription: "The bracket id the team plays in.",
+							Computed:            true,
+						},
+						"fields": schema.MapAttribute{
+							MarkdownDescription: "Values of the custom fields of the team, keyed by field identifier.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -138,6 +147,10 @@ func (data *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			for _, tm := range t.Members {
 				members = append(members, types.StringValue(strconv.Itoa(tm)))
 			}
+			bracketID := types.StringNull()
+			if t.BracketID != nil {
+				bracketID = types.StringValue(strconv.Itoa(*t.BracketID))
+			}
 			state.Teams = append(state.Teams, teamResourceModel{
 				ID:          types.StringValue(strconv.Itoa(t.ID)),
 				Name:        types.StringValue(t.Name),
@@ -150,6 +163,8 @@ func (data *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest
 				Banned:      types.BoolValue(t.Banned),
 				Members:     members,
 				Captain:     types.StringValue(strconv.Itoa(*t.CaptainID)),
+				BracketID:   bracketID,
+				Fields:      flattenFieldEntries(t.Fields),
 			})
 		}
 
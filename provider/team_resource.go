@@ -7,6 +7,7 @@ import (
 
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,21 +23,29 @@ var (
 	_ resource.Resource                = (*teamResource)(nil)
 	_ resource.ResourceWithConfigure   = (*teamResource)(nil)
 	_ resource.ResourceWithImportState = (*teamResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*teamResource)(nil)
 )
 
 type teamResourceModel struct {
-	ID          types.String   `tfsdk:"id"`
-	Name        types.String   `tfsdk:"name"`
-	Email       types.String   `tfsdk:"email"`
-	Password    types.String   `tfsdk:"password"`
-	Website     types.String   `tfsdk:"website"`
-	Affiliation types.String   `tfsdk:"affiliation"`
-	Country     types.String   `tfsdk:"country"`
-	Hidden      types.Bool     `tfsdk:"hidden"`
-	Banned      types.Bool     `tfsdk:"banned"`
-	Members     []types.String `tfsdk:"members"`
-	Captain     types.String   `tfsdk:"captain"`
-	BracketID   types.String   `tfsdk:"bracket_id"`
+	ID          types.String            `tfsdk:"id"`
+	Name        types.String            `tfsdk:"name"`
+	Email       types.String            `tfsdk:"email"`
+	Password    types.String            `tfsdk:"password"`
+	Website     types.String            `tfsdk:"website"`
+	Affiliation types.String            `tfsdk:"affiliation"`
+	Country     types.String            `tfsdk:"country"`
+	Hidden      types.Bool              `tfsdk:"hidden"`
+	Banned      types.Bool              `tfsdk:"banned"`
+	Members     []types.String          `tfsdk:"members"`
+	Captain     types.String            `tfsdk:"captain"`
+	BracketID   types.String            `tfsdk:"bracket_id"`
+	Fields      map[string]types.String `tfsdk:"fields"`
+}
+
+type teamResourceModelWithTimeouts struct {
+	teamResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewTeamResource() resource.Resource {
@@ -114,6 +123,14 @@ func (r *teamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "The bracket id the user plays in.",
 				Optional:            true,
 			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Values of the custom fields of the team (`ctfd_field` of type \"team\"), keyed by field identifier. Values of boolean fields are either \"true\" or \"false\". Only the fields set are managed, the values of the other ones (e.g. filled in by players on registration) are left untouched. On import, the values of all the fields are imported.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -136,16 +153,43 @@ func (r *teamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.fm = fm
 }
 
+func (r *teamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_team")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var bracketID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bracket_id"), &bracketID)...)
+	if !bracketID.IsNull() {
+		r.fm.Capabilities.Require(&resp.Diagnostics, path.Root("bracket_id"), "`ctfd_team` bracket_id", CapabilityBrackets)
+	}
+}
+
 func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data teamResourceModel
+	var data teamResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res, _, err := r.fm.Client.PostTeams(ctx, &api.PostTeamsParams{
 		Name:        data.Name.ValueString(),
 		Email:       data.Email.ValueString(),
@@ -193,6 +237,20 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
+	// => Fields
+	if len(data.Fields) != 0 {
+		entries, err := expandFieldEntries(ctx, r.fm.Client, data.Fields, nil, WithTracerProvider(r.fm.Tp))
+		if err == nil {
+			_, _, err = r.fm.Client.PatchTeamFields(ctx, data.ID.ValueString(), entries, WithTracerProvider(r.fm.Tp))
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to set team %d fields, got error: %s", res.ID, err),
+			)
+			return
+		}
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -204,12 +262,20 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data teamResourceModel
+	var data teamResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
 	teamId := utils.Atoi(data.ID.ValueString())
 	res, _, err := r.fm.Client.GetTeam(ctx, strconv.Itoa(teamId), WithTracerProvider(r.fm.Tp))
 	if err != nil {
@@ -247,6 +313,9 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	// => Captain
 	data.Captain = types.StringValue(strconv.Itoa(*res.CaptainID))
+	// => Fields
+	data.Fields, diags = readFieldEntries(ctx, req.Private, resp.Private, data.Fields, res.Fields)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -258,12 +327,20 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data teamResourceModel
+	var data teamResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	_, _, err := r.fm.Client.PatchTeam(ctx, data.ID.ValueString(), &api.PatchTeamsParams{
 		Name:        data.Name.ValueStringPointer(),
 		Email:       data.Email.ValueStringPointer(),
@@ -351,6 +428,25 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 		return
 	}
+	// => Fields
+	var priorFields map[string]types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("fields"), &priorFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(data.Fields) != 0 || len(priorFields) != 0 {
+		entries, err := expandFieldEntries(ctx, r.fm.Client, data.Fields, priorFields, WithTracerProvider(r.fm.Tp))
+		if err == nil {
+			_, _, err = r.fm.Client.PatchTeamFields(ctx, data.ID.ValueString(), entries, WithTracerProvider(r.fm.Tp))
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to set team %s fields, got error: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -362,12 +458,20 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data teamResourceModel
+	var data teamResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteTeam(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -379,6 +483,7 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(markFieldsImport(ctx, resp.Private)...)
 
 	// Automatically call r.Read
 }
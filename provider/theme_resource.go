@@ -0,0 +1,570 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/utils"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*themeResource)(nil)
+	_ resource.ResourceWithConfigure   = (*themeResource)(nil)
+	_ resource.ResourceWithImportState = (*themeResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*themeResource)(nil)
+)
+
+const (
+	// themeID is the identifier of the theme, as there is only one per
+	// CTFd instance.
+	themeID = "theme"
+
+	// defaultTheme is the theme CTFd is shipped with, restored on destroy.
+	defaultTheme = "core"
+)
+
+func NewThemeResource() resource.Resource {
+	return &themeResource{}
+}
+
+type themeResource struct {
+	fm *Framework
+}
+
+type themeResourceModel struct {
+	ID       types.String                `tfsdk:"id"`
+	Name     types.String                `tfsdk:"name"`
+	Header   types.String                `tfsdk:"header"`
+	Footer   types.String                `tfsdk:"footer"`
+	Settings types.String                `tfsdk:"settings"`
+	Logo     *themeImageSubresourceModel `tfsdk:"logo"`
+	Banner   *themeImageSubresourceModel `tfsdk:"banner"`
+	Favicon  *themeImageSubresourceModel `tfsdk:"favicon"`
+	Timeouts timeouts.Value              `tfsdk:"timeouts"`
+}
+
+type themeImageSubresourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ContentB64 types.String `tfsdk:"contentb64"`
+	SHA1Sum    types.String `tfsdk:"sha1sum"`
+	Location   types.String `tfsdk:"location"`
+}
+
+// themeKey binds a text attribute of the theme to its config key.
+type themeKey struct {
+	key   string
+	value *types.String
+}
+
+func (data *themeResourceModel) keys() []themeKey {
+	return []themeKey{
+		{key: "theme_header", value: &data.Header},
+		{key: "theme_footer", value: &data.Footer},
+		{key: "theme_settings", value: &data.Settings},
+	}
+}
+
+// themeImage binds an image of the theme to its config key, which value
+// is the location of the page file.
+type themeImage struct {
+	attr  string
+	key   string
+	value **themeImageSubresourceModel
+}
+
+func (data *themeResourceModel) images() []themeImage {
+	return []themeImage{
+		{attr: "logo", key: "ctf_logo", value: &data.Logo},
+		{attr: "banner", key: "ctf_banner", value: &data.Banner},
+		{attr: "favicon", key: "ctf_small_icon", value: &data.Favicon},
+	}
+}
+
+func (r *themeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_theme"
+}
+
+func (r *themeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The branding of the CTFd instance, i.e. its theme, header and footer, theme settings, and logo, banner and favicon images.\n\nThe resource is authoritative over all of them, such that an unset attribute is removed from CTFd, and a change through the web UI is reported as a drift. The images are uploaded as page files, and replaced once their content changes. There is only one per CTFd instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the theme, always `" + themeID + "`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the theme to use. Default to `" + defaultTheme + "`, which is restored on destroy.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultTheme),
+			},
+			"header": schema.StringAttribute{
+				MarkdownDescription: "HTML content injected in the header of every page (e.g. styles or analytics scripts).",
+				Optional:            true,
+			},
+			"footer": schema.StringAttribute{
+				MarkdownDescription: "HTML content injected in the footer of every page.",
+				Optional:            true,
+			},
+			"settings": schema.StringAttribute{
+				MarkdownDescription: "Settings of the theme, as a JSON document (e.g. `jsonencode({ challenge_window_size = \"xl\" })`). Their keys depend on the theme.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewJSONValidator(),
+				},
+			},
+			"logo":    themeImageAttribute("Logo of the CTF, displayed in the navigation bar in place of its name."),
+			"banner":  themeImageAttribute("Banner of the CTF, displayed on the index page."),
+			"favicon": themeImageAttribute("Favicon of the CTF, displayed in the browser tabs."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func themeImageAttribute(desc string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: desc,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the page file.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the file (e.g. `logo.png`).",
+				Required:            true,
+			},
+			"contentb64": schema.StringAttribute{
+				MarkdownDescription: "Base 64 content of the image. You could provide it from the file-system using `filebase64(\"${path.module}/...\")`.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"sha1sum": schema.StringAttribute{
+				MarkdownDescription: "The sha1 sum of the image, such that a change of content is visible in the plan.",
+				Computed:            true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Location where the image is stored on the CTFd instance.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *themeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *themeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_theme")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, dataState themeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &dataState)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The sha1 sums are known ahead, such that an image is only uploaded
+	// again once its content changed
+	images, imagesState := data.images(), dataState.images()
+	for i, img := range images {
+		plan, state := *img.value, *imagesState[i].value
+		if plan == nil || plan.ContentB64.IsUnknown() {
+			continue
+		}
+		content, err := base64.StdEncoding.DecodeString(plan.ContentB64.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(img.attr).AtName("contentb64"),
+				"Content Error",
+				fmt.Sprintf("base64 content is invalid: %s", err),
+			)
+			return
+		}
+		plan.SHA1Sum = types.StringValue(sha1sum(content))
+
+		if state != nil && state.SHA1Sum.Equal(plan.SHA1Sum) && state.Name.Equal(plan.Name) {
+			plan.ID = state.ID
+			plan.Location = state.Location
+		} else {
+			plan.ID = types.StringUnknown()
+			plan.Location = types.StringUnknown()
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+func (r *themeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data themeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.apply(ctx, &data, &themeResourceModel{}); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to set theme, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "set the theme")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(themeID)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *themeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data themeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	current, err := r.getConfigs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get theme, got error: %s", err),
+		)
+		return
+	}
+
+	if v, ok := current["ctf_theme"]; ok && v != "" {
+		data.Name = types.StringValue(v)
+	} else {
+		data.Name = types.StringValue(defaultTheme)
+	}
+	for _, k := range data.keys() {
+		v, ok := current[k.key]
+		if !ok {
+			*k.value = types.StringNull()
+			continue
+		}
+		// Keep the JSON representation of the settings if it did not change
+		if k.key == "theme_settings" && !k.value.IsNull() && jsonEqual(k.value.ValueString(), v) {
+			continue
+		}
+		*k.value = types.StringValue(v)
+	}
+
+	for _, img := range data.images() {
+		location := current[img.key]
+		if location == "" {
+			*img.value = nil
+			continue
+		}
+		image, err := r.readImage(ctx, *img.value, location)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read theme %s at location %s, got error: %s", img.attr, location, err),
+			)
+			return
+		}
+		*img.value = image
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *themeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data, dataState themeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &dataState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if err := r.apply(ctx, &data, &dataState); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update theme, got error: %s", err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *themeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data themeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Restore the default theme, without any customization
+	if err := r.apply(ctx, &themeResourceModel{
+		Name:     types.StringValue(defaultTheme),
+		Header:   types.StringNull(),
+		Footer:   types.StringNull(),
+		Settings: types.StringNull(),
+	}, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete theme, got error: %s", err))
+		return
+	}
+}
+
+func (r *themeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), themeID)...)
+
+	// Automatically call r.Read
+}
+
+// getConfigs returns the config values by key.
+func (r *themeResource) getConfigs(ctx context.Context) (map[string]string, error) {
+	configs, _, err := r.fm.Client.GetConfigs(ctx, &api.GetConfigsParams{}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]string, len(configs))
+	for _, cfg := range configs {
+		current[cfg.Key] = cfg.Value
+	}
+	return current, nil
+}
+
+// readImage returns the image at the location. The prior one is kept if it
+// has not been replaced, else the page file is looked for.
+func (r *themeResource) readImage(ctx context.Context, prior *themeImageSubresourceModel, location string) (*themeImageSubresourceModel, error) {
+	image := &themeImageSubresourceModel{
+		ID:       types.StringNull(),
+		Name:     types.StringValue(filepath.Base(location)),
+		Location: types.StringValue(location),
+	}
+	if prior != nil && prior.Location.ValueString() == location {
+		image.ID = prior.ID
+		image.Name = prior.Name
+	} else {
+		files, _, err := r.fm.Client.GetFiles(ctx, &api.GetFilesParams{
+			Type:     utils.Ptr("page"),
+			Location: utils.Ptr(location),
+		}, WithTracerProvider(r.fm.Tp))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) != 0 {
+			image.ID = types.StringValue(strconv.Itoa(files[0].ID))
+		}
+	}
+
+	content, err := r.fm.Client.GetFileContent(ctx, &api.File{
+		Location: location,
+	}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		return nil, err
+	}
+	image.ContentB64 = types.StringValue(base64.StdEncoding.EncodeToString(content))
+	image.SHA1Sum = types.StringValue(sha1sum(content))
+	return image, nil
+}
+
+// apply sets the theme, uploads the images that changed since the prior
+// ones, and removes the unset attributes. The replaced page files are
+// deleted once no longer referenced.
+func (r *themeResource) apply(ctx context.Context, data, prior *themeResourceModel) error {
+	current, err := r.getConfigs(ctx)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]string{
+		"ctf_theme": data.Name.ValueString(),
+	}
+	for _, k := range data.keys() {
+		if k.value.IsNull() {
+			if _, ok := current[k.key]; ok {
+				if _, err := r.fm.Client.DeleteConfigsByKey(ctx, k.key, WithTracerProvider(r.fm.Tp)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		values[k.key] = k.value.ValueString()
+	}
+
+	obsolete := []string{}
+	images, priorImages := data.images(), prior.images()
+	for i, img := range images {
+		image, priorImage := *img.value, *priorImages[i].value
+		if priorImage != nil && !priorImage.ID.IsNull() && !priorImage.ID.IsUnknown() &&
+			(image == nil || !image.ID.Equal(priorImage.ID)) {
+			obsolete = append(obsolete, priorImage.ID.ValueString())
+		}
+
+		if image == nil {
+			if current[img.key] != "" {
+				if _, err := r.fm.Client.DeleteConfigsByKey(ctx, img.key, WithTracerProvider(r.fm.Tp)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if !image.ID.IsUnknown() {
+			values[img.key] = image.Location.ValueString()
+			continue
+		}
+
+		content, err := base64.StdEncoding.DecodeString(image.ContentB64.ValueString())
+		if err != nil {
+			return fmt.Errorf("invalid %s base64 content: %w", img.attr, err)
+		}
+		res, _, err := r.fm.Client.PostPageFiles(ctx, []*api.InputFile{
+			{
+				Name:    image.Name.ValueString(),
+				Content: content,
+			},
+		}, WithTracerProvider(r.fm.Tp))
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return fmt.Errorf("no %s file returned by CTFd", img.attr)
+		}
+		// The planned checksum is kept, the one of CTFd only checks the upload
+		if res[0].SHA1sum != "" && res[0].SHA1sum != image.SHA1Sum.ValueString() {
+			return fmt.Errorf("%s upload is corrupted, CTFd computed SHA1 %s while %s is expected", img.attr, res[0].SHA1sum, image.SHA1Sum.ValueString())
+		}
+		image.ID = types.StringValue(strconv.Itoa(res[0].ID))
+		image.Location = types.StringValue(res[0].Location)
+		values[img.key] = res[0].Location
+	}
+
+	if _, err := r.fm.Client.PatchConfigs(ctx, values, WithTracerProvider(r.fm.Tp)); err != nil {
+		return err
+	}
+
+	for _, id := range obsolete {
+		if _, err := r.fm.Client.DeleteFile(ctx, id, WithTracerProvider(r.fm.Tp)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sha1sum(content []byte) string {
+	sum := sha1.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// jsonEqual returns whether both JSON documents are semantically equal.
+func jsonEqual(a, b string) bool {
+	var va, vb any
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
+	}
+	ba, _ := json.Marshal(va)
+	bb, _ := json.Marshal(vb)
+	return bytes.Equal(ba, bb)
+}
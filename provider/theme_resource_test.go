@@ -0,0 +1,74 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// pixel is a 1x1 PNG image, base64-encoded.
+const (
+	pixel = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+	// pixelSHA1 is the SHA1 sum of the decoded pixel
+	pixelSHA1 = "c1986af3c26609b8b7d8933f99c51c1a89e9ea6b"
+)
+
+func TestAcc_Theme_Lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_theme" "branding" {
+	header = "<style></style>"
+	settings = jsonencode({
+		challenge_window_size = "xl"
+	})
+
+	logo = {
+		name       = "logo.png"
+		contentb64 = "` + pixel + `"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_theme.branding", "id", "theme"),
+					resource.TestCheckResourceAttr("ctfd_theme.branding", "name", "core"),
+					resource.TestCheckResourceAttrSet("ctfd_theme.branding", "logo.location"),
+					resource.TestCheckResourceAttr("ctfd_theme.branding", "logo.sha1sum", pixelSHA1),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "ctfd_theme.branding",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_theme" "branding" {
+	footer = "<footer>CTFer.io</footer>"
+
+	banner = {
+		name       = "banner.png"
+		contentb64 = "` + pixel + `"
+	}
+	favicon = {
+		name       = "favicon.png"
+		contentb64 = "` + pixel + `"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("ctfd_theme.branding", "header"),
+					resource.TestCheckNoResourceAttr("ctfd_theme.branding", "logo"),
+					resource.TestCheckResourceAttrSet("ctfd_theme.branding", "banner.location"),
+					resource.TestCheckResourceAttrPair("ctfd_theme.branding", "banner.sha1sum", "ctfd_theme.branding", "favicon.sha1sum"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
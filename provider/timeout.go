@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultTimeout is the timeout of resources operations when their
+// timeouts block does not define it.
+const defaultTimeout = 20 * time.Minute
+
+// timeoutsBlock is the schema of the resources timeouts block.
+// Resources sharing their model with a data source embed it in a
+// <model>WithTimeouts struct that adds the timeouts, as data sources have
+// no such block.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: "Maximum duration of the creation (e.g. `30m`). Default to `20m`.",
+		ReadDescription:   "Maximum duration of the read, occurring on every refresh (e.g. `30m`). Default to `20m`.",
+		UpdateDescription: "Maximum duration of the update (e.g. `30m`). Default to `20m`.",
+		DeleteDescription: "Maximum duration of the deletion (e.g. `30m`). Only applies if set before the destroy, i.e. saved in the state. Default to `20m`.",
+	})
+}
+
+// withTimeout returns a copy of ctx cancelled after timeout.
+// The current span records the timeout, and is marked as failed if it is
+// exceeded by the time the returned function is called.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("timeout", timeout.String()))
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			span.SetAttributes(attribute.Bool("timeout.exceeded", true))
+			span.SetStatus(codes.Error, "timeout exceeded")
+		}
+		cancel()
+	}
+}
+
+// timeoutTransport bounds the duration of every request, until its
+// response body is closed.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+var _ http.RoundTripper = (*timeoutTransport)(nil)
+
+func (rt *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := withTimeout(req.Context(), rt.timeout)
+
+	res, err := rt.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelBody{
+		ReadCloser: res.Body,
+		cancel:     cancel,
+	}
+	return res, nil
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		timeout  time.Duration
+		wait     time.Duration
+		exceeded bool
+	}{
+		"in-time": {
+			timeout: time.Second,
+		},
+		"exceeded": {
+			timeout:  10 * time.Millisecond,
+			wait:     50 * time.Millisecond,
+			exceeded: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+			ctx, span := tp.Tracer("test").Start(context.Background(), "op")
+
+			ctx, cancel := withTimeout(ctx, tt.timeout)
+			time.Sleep(tt.wait)
+			if got := errors.Is(ctx.Err(), context.DeadlineExceeded); got != tt.exceeded {
+				t.Errorf("got deadline exceeded %t, want %t", got, tt.exceeded)
+			}
+			cancel()
+			span.End()
+
+			// The span records the timeout, and fails if exceeded
+			s := rec.Ended()[0]
+			attrs := attribute.NewSet(s.Attributes()...)
+			if v, _ := attrs.Value("timeout"); v.AsString() != tt.timeout.String() {
+				t.Errorf("got timeout attribute %q, want %q", v.AsString(), tt.timeout)
+			}
+			if v, _ := attrs.Value("timeout.exceeded"); v.AsBool() != tt.exceeded {
+				t.Errorf("got timeout.exceeded attribute %t, want %t", v.AsBool(), tt.exceeded)
+			}
+			if got := s.Status().Code == codes.Error; got != tt.exceeded {
+				t.Errorf("got span status %v", s.Status())
+			}
+		})
+	}
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		// Answers in time, but its body is read later on
+		w.(http.Flusher).Flush()
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte("ctfer"))
+	}))
+	t.Cleanup(srv.Close)
+
+	rt := &timeoutTransport{
+		next:    http.DefaultTransport,
+		timeout: 200 * time.Millisecond,
+	}
+
+	t.Run("slow", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"/slow", nil)
+		start := time.Now()
+		_, err := rt.RoundTrip(req)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("got error %v, want deadline exceeded", err)
+		}
+		if d := time.Since(start); d > 2*time.Second {
+			t.Errorf("request lasted %s", d)
+		}
+	})
+
+	t.Run("body", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"/fast", nil)
+		res, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The request is only cancelled once its body is closed
+		b, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "ctfer" {
+			t.Errorf("got body %q", b)
+		}
+	})
+}
+
+func TestConfigure_RequestTimeout(t *testing.T) {
+	resp := testConfigure(t, map[string]tftypes.Value{
+		"url":             tftypes.NewValue(tftypes.String, "https://ctfd.ctfer.io"),
+		"api_key":         tftypes.NewValue(tftypes.String, "ctfd_test"),
+		"request_timeout": tftypes.NewValue(tftypes.String, "0s"),
+	}, false)
+	if got := resp.Diagnostics.ErrorsCount(); got != 1 {
+		t.Fatalf("got %d errors, want 1", got)
+	}
+	d := resp.Diagnostics.Errors()[0]
+	if wd, ok := d.(diag.DiagnosticWithPath); !ok || !wd.Path().Equal(path.Root("request_timeout")) {
+		t.Errorf("got %v, want an error on request_timeout", d)
+	}
+}
@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*tokenResource)(nil)
+	_ resource.ResourceWithConfigure   = (*tokenResource)(nil)
+	_ resource.ResourceWithImportState = (*tokenResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*tokenResource)(nil)
+)
+
+func NewTokenResource() resource.Resource {
+	return &tokenResource{}
+}
+
+type tokenResource struct {
+	fm *Framework
+}
+
+type tokenResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Username    types.String   `tfsdk:"username"`
+	Password    types.String   `tfsdk:"password"`
+	UserID      types.String   `tfsdk:"user_id"`
+	Description types.String   `tfsdk:"description"`
+	Expiration  types.String   `tfsdk:"expiration"`
+	RotateAfter types.String   `tfsdk:"rotate_after"`
+	Created     types.String   `tfsdk:"created"`
+	Value       types.String   `tfsdk:"value"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *tokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+func (r *tokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An access token of CTFd, to use short-lived API keys rather than long-lived ones (e.g. in a CI).\n\nCTFd does not permit updating a token: any change but `password` and `rotate_after` requires a replacement, i.e. issuing a new token and deleting the previous one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the token, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Name of the user to issue the token for, e.g. a dedicated administrator. If not set, the token is issued for the user the provider is authenticated as. Requires `password`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user to issue the token for, only used on creation. Requires `username`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user the token has been issued for.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the token, e.g. what it is used for.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				MarkdownDescription: "Expiration date of the token (e.g. `2026-12-31`). If not set, CTFd defaults to 30 days after its issuance.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				MarkdownDescription: "Duration after the token issuance to replace it with a new one on the next apply (e.g. `168h`). It should be lower than the token lifetime, for it to be rotated before it expires.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewDurationValidator(),
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "Date-time the token has been issued at.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the token, to use as an API key. It is only returned by CTFd on issuance, so is null once imported.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *tokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	fm, ok := req.ProviderData.(*Framework)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", (*Framework)(nil), req.ProviderData),
+		)
+		return
+	}
+
+	r.fm = fm
+}
+
+func (r *tokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_token")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data tokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Username.IsNull() != data.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Invalid token user",
+			"Both username and password must be set to issue the token for another user, or none to issue it for the authenticated one.",
+		)
+	}
+	if !data.Expiration.IsNull() && !data.Expiration.IsUnknown() {
+		if _, err := time.Parse(apiKeyExpirationLayout, data.Expiration.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration"),
+				"Invalid token expiration",
+				fmt.Sprintf("The token expiration must be a date (e.g. 2026-12-31), got %q.", data.Expiration.ValueString()),
+			)
+		}
+	}
+
+	// Nothing else to check on creation.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Replace the token once it is older than rotate_after. Terraform only
+	// replaces on a changed value, so the issuance date is planned unknown.
+	if data.RotateAfter.IsNull() || data.RotateAfter.IsUnknown() {
+		return
+	}
+	rotateAfter, err := time.ParseDuration(data.RotateAfter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("rotate_after"),
+			"Token rotation skipped",
+			fmt.Sprintf("The rotation delay %q could not be parsed, the token is not rotated: %s", data.RotateAfter.ValueString(), err),
+		)
+		return
+	}
+	var state tokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	created, err := validators.ParseDateTime(state.Created.ValueString(), time.UTC)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("created"),
+			"Token rotation skipped",
+			fmt.Sprintf("The issuance date %q of the token could not be parsed, the token is not rotated: %s", state.Created.ValueString(), err),
+		)
+		return
+	}
+	if time.Now().Before(created.Add(rotateAfter)) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("created"))
+}
+
+func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data tokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Issue the token for another user through its own session
+	cli := r.fm.Client
+	if !data.Username.IsNull() {
+		var err error
+		cli, err = r.fm.Client.NewSession(ctx, &api.LoginParams{
+			Name:     data.Username.ValueString(),
+			Password: data.Password.ValueString(),
+		}, WithTracerProvider(r.fm.Tp))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to login as %s, got error: %s", data.Username.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	// Create token
+	expiration := ""
+	if !data.Expiration.IsUnknown() {
+		expiration = data.Expiration.ValueString()
+	}
+	res, _, err := cli.PostTokens(ctx, &api.PostTokensParams{
+		Description: data.Description.ValueString(),
+		Expiration:  expiration,
+	}, WithTracerProvider(r.fm.Tp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create token, got error: %s", err),
+		)
+		return
+	}
+	if res.Value == nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			"Unable to create token, CTFd did not return its value.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a token")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+	data.Value = types.StringValue(*res.Value)
+	flattenToken(&data, res)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data tokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, _, err := r.fm.Client.GetToken(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
+	if errors.Is(err, ErrNotFound) {
+		// The token has been revoked out of Terraform, so it has to be issued again
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read token %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Upsert values, the token value is never returned again
+	flattenToken(&data, res)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *tokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data tokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the password, rotate_after and timeouts could have changed, and
+	// they are not part of the CTFd token
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
+	defer span.End()
+
+	var data tokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if _, err := r.fm.Client.DeleteToken(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete token %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *tokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
+
+// flattenToken upserts the values CTFd returns for a token in the model.
+// The expiration is truncated to its date, as it is configured.
+func flattenToken(data *tokenResourceModel, token *api.Token) {
+	data.UserID = types.StringNull()
+	if token.UserID != nil {
+		data.UserID = types.StringValue(strconv.Itoa(*token.UserID))
+	}
+	data.Description = types.StringValue("")
+	if token.Description != nil {
+		data.Description = types.StringValue(*token.Description)
+	}
+	data.Expiration = types.StringValue(token.Expiration)
+	if len(token.Expiration) >= len(apiKeyExpirationLayout) {
+		data.Expiration = types.StringValue(token.Expiration[:len(apiKeyExpirationLayout)])
+	}
+	data.Created = types.StringNull()
+	if token.Created != nil {
+		data.Created = types.StringValue(*token.Created)
+	}
+}
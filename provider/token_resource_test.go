@@ -0,0 +1,86 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Token_Lifecycle(t *testing.T) {
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_token" "ci" {
+	description  = "CI pipeline"
+	expiration   = "2099-12-31"
+	rotate_after = "720h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("ctfd_token.ci", "id"),
+					resource.TestCheckResourceAttrSet("ctfd_token.ci", "value"),
+					resource.TestCheckResourceAttrSet("ctfd_token.ci", "created"),
+					resource.TestCheckResourceAttr("ctfd_token.ci", "expiration", "2099-12-31"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "ctfd_token.ci",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value", "rotate_after"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "ctfd_token" "ci" {
+	description  = "CI pipeline"
+	expiration   = "2099-12-31"
+	rotate_after = "8760h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ctfd_token.ci", "rotate_after", "8760h"),
+					resource.TestCheckResourceAttrSet("ctfd_token.ci", "value"),
+					resource.TestCheckResourceAttrWith("ctfd_token.ci", "id", func(v string) error {
+						id = v
+						return nil
+					}),
+				),
+			},
+			// Revoked out of Terraform, then issued again
+			{
+				PreConfig: func() {
+					if _, err := testAccClient(t).DeleteToken(context.Background(), id); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: providerConfig + `
+resource "ctfd_token" "ci" {
+	description  = "CI pipeline"
+	expiration   = "2099-12-31"
+	rotate_after = "8760h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ctfd_token.ci", "value"),
+					resource.TestCheckResourceAttrWith("ctfd_token.ci", "id", func(v string) error {
+						if v == id {
+							return fmt.Errorf("token %s has not been issued again", id)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// TLSOptions configures how the provider authenticates the CTFd instance,
+// and authenticates itself to it (mTLS).
+type TLSOptions struct {
+	// CACertPEM is a PEM-encoded CA certificate bundle to trust in addition
+	// to the system ones.
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM are a PEM-encoded certificate and private key
+	// presented to the CTFd instance (or its ingress).
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// InsecureSkipVerify disables the verification of the CTFd certificate.
+	InsecureSkipVerify bool
+}
+
+// TLSConfig builds the *tls.Config corresponding to the options.
+func (o TLSOptions) TLSConfig() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.InsecureSkipVerify, // #nosec G402 -- explicitly requested by the user
+	}
+
+	if o.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(o.CACertPEM)) {
+			return nil, errors.New("no valid certificate found in CA certificate PEM")
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (o.ClientCertPEM == "") != (o.ClientKeyPEM == "") {
+		return nil, errors.New("client certificate and key must be set together")
+	}
+	if o.ClientCertPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(o.ClientCertPEM), []byte(o.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// NewTransport creates an HTTP transport instrumented with OpenTelemetry.
+// If tlsConfig is nil, the default TLS configuration is used.
+// If proxyURL is nil, the proxy is defined by the environment (HTTPS_PROXY...).
+// The headers are added to every request, but do not override the ones set
+// by the client (e.g. Authorization).
+func NewTransport(tlsConfig *tls.Config, proxyURL *url.URL, headers map[string]string) http.RoundTripper {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		base.TLSClientConfig = tlsConfig
+	}
+	if proxyURL != nil {
+		base.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var tp http.RoundTripper = otelhttp.NewTransport(base)
+	if len(headers) != 0 {
+		tp = &headersTransport{
+			next:    tp,
+			headers: headers,
+		}
+	}
+	return tp
+}
+
+// headersTransport adds custom headers to every request, e.g. for
+// an identity-aware proxy in front of CTFd.
+type headersTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+var _ http.RoundTripper = (*headersTransport)(nil)
+
+func (rt *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range rt.headers {
+		if req.Header.Get(k) == "" {
+			req.Header.Set(k, v)
+		}
+	}
+	return rt.next.RoundTrip(req)
+}
+
+// ParseProxyURL parses and checks the URL of an HTTP(S) or SOCKS5 proxy.
+// It returns nil if raw is empty.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q, expected http, https, socks5 or socks5h", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, errors.New("missing proxy host")
+	}
+	return u, nil
+}
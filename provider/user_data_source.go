@@ -91,6 +91,15 @@ func (data *userDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							MarkdownDescription: "Is true if the user is banned from the CTF.",
 							Computed:            true,
 						},
+						"bracket_id": schema.StringAttribute{
+							MarkdownDescription: "The bracket id the user plays in.",
+							Computed:            true,
+						},
+						"fields": schema.MapAttribute{
+							MarkdownDescription: "Values of the custom fields of the user, keyed by field identifier.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -137,6 +146,11 @@ func (data *userDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		}
 
 		for _, u := range usrs {
+			bracketID := types.StringNull()
+			if u.BracketID != nil {
+				bracketID = types.StringValue(strconv.Itoa(*u.BracketID))
+			}
+
 			// Flatten response
 			state.Users = append(state.Users, userResourceModel{
 				ID:          types.StringValue(strconv.Itoa(u.ID)),
@@ -151,6 +165,8 @@ func (data *userDataSource) Read(ctx context.Context, req datasource.ReadRequest
 				Verified:    types.BoolPointerValue(u.Verified),
 				Hidden:      types.BoolPointerValue(u.Hidden),
 				Banned:      types.BoolPointerValue(u.Banned),
+				BracketID:   bracketID,
+				Fields:      flattenFieldEntries(u.Fields),
 			})
 		}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/ctfer-io/terraform-provider-ctfd/v2/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,22 +25,30 @@ var (
 	_ resource.Resource                = (*userResource)(nil)
 	_ resource.ResourceWithConfigure   = (*userResource)(nil)
 	_ resource.ResourceWithImportState = (*userResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*userResource)(nil)
 )
 
 type userResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Email       types.String `tfsdk:"email"`
-	Password    types.String `tfsdk:"password"`
-	Website     types.String `tfsdk:"website"`
-	Affiliation types.String `tfsdk:"affiliation"`
-	Country     types.String `tfsdk:"country"`
-	Language    types.String `tfsdk:"language"`
-	Type        types.String `tfsdk:"type"`
-	Verified    types.Bool   `tfsdk:"verified"`
-	Hidden      types.Bool   `tfsdk:"hidden"`
-	Banned      types.Bool   `tfsdk:"banned"`
-	BracketID   types.String `tfsdk:"bracket_id"`
+	ID          types.String            `tfsdk:"id"`
+	Name        types.String            `tfsdk:"name"`
+	Email       types.String            `tfsdk:"email"`
+	Password    types.String            `tfsdk:"password"`
+	Website     types.String            `tfsdk:"website"`
+	Affiliation types.String            `tfsdk:"affiliation"`
+	Country     types.String            `tfsdk:"country"`
+	Language    types.String            `tfsdk:"language"`
+	Type        types.String            `tfsdk:"type"`
+	Verified    types.Bool              `tfsdk:"verified"`
+	Hidden      types.Bool              `tfsdk:"hidden"`
+	Banned      types.Bool              `tfsdk:"banned"`
+	BracketID   types.String            `tfsdk:"bracket_id"`
+	Fields      map[string]types.String `tfsdk:"fields"`
+}
+
+type userResourceModelWithTimeouts struct {
+	userResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewUserResource() resource.Resource {
@@ -129,6 +138,14 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "The bracket id the user plays in.",
 				Optional:            true,
 			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Values of the custom fields of the user (`ctfd_field` of type \"user\"), keyed by field identifier. Values of boolean fields are either \"true\" or \"false\". Only the fields set are managed, the values of the other ones (e.g. filled in by players on registration) are left untouched. On import, the values of all the fields are imported.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -151,16 +168,43 @@ func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.fm = fm
 }
 
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check if the provider has not been configured.
+	if r.fm == nil {
+		return
+	}
+	defer r.fm.rejectChanges(req.State, &resp.Plan, &resp.Diagnostics, "ctfd_user")
+
+	// Nothing else to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var bracketID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bracket_id"), &bracketID)...)
+	if !bracketID.IsNull() {
+		r.fm.Capabilities.Require(&resp.Diagnostics, path.Root("bracket_id"), "`ctfd_user` bracket_id", CapabilityBrackets)
+	}
+}
+
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data userResourceModel
+	var data userResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res, _, err := r.fm.Client.PostUsers(ctx, &api.PostUsersParams{
 		Name:        data.Name.ValueString(),
 		Email:       data.Email.ValueString(),
@@ -186,6 +230,21 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	data.ID = types.StringValue(strconv.Itoa(res.ID))
 
+	// => Fields
+	if len(data.Fields) != 0 {
+		entries, err := expandFieldEntries(ctx, r.fm.Client, data.Fields, nil, WithTracerProvider(r.fm.Tp))
+		if err == nil {
+			_, _, err = r.fm.Client.PatchUserFields(ctx, data.ID.ValueString(), entries, WithTracerProvider(r.fm.Tp))
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to set user %s fields, got error: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -196,12 +255,20 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data userResourceModel
+	var data userResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
 	res, _, err := r.fm.Client.GetUser(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp))
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -226,6 +293,10 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	// password is not returned, which is good :)
 
+	// => Fields
+	data.Fields, diags = readFieldEntries(ctx, req.Private, resp.Private, data.Fields, res.Fields)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -236,12 +307,20 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data userResourceModel
+	var data userResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	_, _, err := r.fm.Client.PatchUser(ctx, data.ID.ValueString(), &api.PatchUsersParams{
 		Name:        data.Name.ValueString(),
 		Email:       data.Email.ValueString(),
@@ -265,6 +344,26 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// => Fields
+	var priorFields map[string]types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("fields"), &priorFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(data.Fields) != 0 || len(priorFields) != 0 {
+		entries, err := expandFieldEntries(ctx, r.fm.Client, data.Fields, priorFields, WithTracerProvider(r.fm.Tp))
+		if err == nil {
+			_, _, err = r.fm.Client.PatchUserFields(ctx, data.ID.ValueString(), entries, WithTracerProvider(r.fm.Tp))
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to set user %s fields, got error: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -275,12 +374,20 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	ctx, span := StartTFSpan(ctx, r.fm.Tp.Tracer(serviceName), r)
 	defer span.End()
 
-	var data userResourceModel
+	var data userResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := r.fm.Client.DeleteUser(ctx, data.ID.ValueString(), WithTracerProvider(r.fm.Tp)); err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -292,6 +399,7 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(markFieldsImport(ctx, resp.Private)...)
 
 	// Automatically call r.Read
 }
@@ -0,0 +1,105 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+	// Embed the timezone database, as it is not available on all systems
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// DateTimeLayouts are the accepted date-time layouts: RFC3339, or without
+// offset when the timezone is defined elsewhere.
+var DateTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+}
+
+// ParseDateTime parses a date-time in one of DateTimeLayouts. If it has no
+// offset, it is interpreted in the given location.
+func ParseDateTime(value string, loc *time.Location) (time.Time, error) {
+	var err error
+	for _, layout := range DateTimeLayouts {
+		var t time.Time
+		t, err = time.ParseInLocation(layout, value, loc)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// DateTimeValidator validates a string value is a date-time, either
+// RFC3339 (e.g. "2026-10-17T09:00:00+02:00") or without offset
+// (e.g. "2026-10-17T09:00:00").
+type DateTimeValidator struct{}
+
+func NewDateTimeValidator() *DateTimeValidator {
+	return &DateTimeValidator{}
+}
+
+var _ validator.String = (*DateTimeValidator)(nil)
+
+func (val *DateTimeValidator) Description(ctx context.Context) string {
+	return "Validates a string value is a date-time."
+}
+
+func (val *DateTimeValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates a string value is a date-time."
+}
+
+func (val *DateTimeValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseDateTime(req.ConfigValue.ValueString(), time.UTC); err != nil {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"DateTimeValidator Error",
+			fmt.Sprintf("Invalid date-time, expected RFC3339 (e.g. \"2026-10-17T09:00:00+02:00\"): %s", err),
+		)
+	}
+}
+
+// TimezoneValidator validates a string value is an IANA timezone
+// (e.g. "Europe/Paris").
+type TimezoneValidator struct{}
+
+func NewTimezoneValidator() *TimezoneValidator {
+	return &TimezoneValidator{}
+}
+
+var _ validator.String = (*TimezoneValidator)(nil)
+
+func (val *TimezoneValidator) Description(ctx context.Context) string {
+	return "Validates a string value is a timezone."
+}
+
+func (val *TimezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates a string value is a timezone."
+}
+
+func (val *TimezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.LoadLocation(req.ConfigValue.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"TimezoneValidator Error",
+			fmt.Sprintf("Invalid timezone, expected an IANA name (e.g. \"Europe/Paris\"): %s", err),
+		)
+	}
+}
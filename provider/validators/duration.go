@@ -0,0 +1,72 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// DurationValidator validates a string value is a valid Go duration
+// (e.g. "500ms", "30s", "1h").
+type DurationValidator struct {
+	positive bool
+}
+
+func NewDurationValidator() *DurationValidator {
+	return &DurationValidator{}
+}
+
+// NewPositiveDurationValidator also rejects a zero duration, e.g. for
+// a polling interval.
+func NewPositiveDurationValidator() *DurationValidator {
+	return &DurationValidator{
+		positive: true,
+	}
+}
+
+var _ validator.String = (*DurationValidator)(nil)
+
+func (val *DurationValidator) Description(ctx context.Context) string {
+	return "Validates a string value is a duration."
+}
+
+func (val *DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates a string value is a duration."
+}
+
+func (val *DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"DurationValidator Error",
+			fmt.Sprintf("Invalid duration: %s", err),
+		)
+		return
+	}
+	if d < 0 {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"DurationValidator Error",
+			"Duration must not be negative.",
+		)
+		return
+	}
+	if val.positive && d == 0 {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"DurationValidator Error",
+			"Duration must be positive.",
+		)
+	}
+}
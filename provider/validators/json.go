@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// JSONValidator validates a string value is a valid JSON document
+// (e.g. `{"color": "dark"}`).
+type JSONValidator struct{}
+
+func NewJSONValidator() *JSONValidator {
+	return &JSONValidator{}
+}
+
+var _ validator.String = (*JSONValidator)(nil)
+
+func (val *JSONValidator) Description(ctx context.Context) string {
+	return "Validates a string value is a JSON document."
+}
+
+func (val *JSONValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates a string value is a JSON document."
+}
+
+func (val *JSONValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"JSONValidator Error",
+			"Invalid JSON document.",
+		)
+	}
+}